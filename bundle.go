@@ -0,0 +1,104 @@
+package migrator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// TableBundle maps table names to the commands, which should be applied on them.
+// Each table is altered with a separate statement, tables are processed in alphabetical order.
+type TableBundle map[string]TableCommands
+
+// BundleTables builds the bundle applying the same commands on every table.
+//
+// Example:
+//		migrator.BundleTables(
+//			migrator.TableCommands{migrator.AddColumnCommand{Name: "tenant_id", Column: migrator.Integer{Unsigned: true}}},
+//			"posts", "comments",
+//		)
+func BundleTables(c TableCommands, tables ...string) TableBundle {
+	b := TableBundle{}
+
+	for _, table := range tables {
+		b[table] = c
+	}
+
+	return b
+}
+
+// Commands returns ALTER TABLE command for every table in deterministic order.
+func (b TableBundle) Commands() []Command {
+	tables := []string{}
+	for table := range b {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	commands := []Command{}
+	for _, table := range tables {
+		commands = append(commands, alterTableCommand{table, b[table]})
+	}
+
+	return commands
+}
+
+// ColumnBackfill describes the column addition followed by the data backfill.
+// The column is added with the nullable definition, filled with the expression value
+// and optionally modified with NOT NULL definition afterwards.
+//
+// Example:
+//		migrator.ColumnBackfill{
+//			Table:      "users",
+//			Name:       "full_name",
+//			Column:     migrator.String{Precision: 255, Nullable: migrator.Null},
+//			Expression: "CONCAT(first_name, ' ', last_name)",
+//			NotNull:    migrator.String{Precision: 255},
+//		}
+//			↪️ ALTER TABLE `users` ADD COLUMN `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NULL
+//			↪️ UPDATE `users` SET `full_name` = CONCAT(first_name, ' ', last_name)
+//			↪️ ALTER TABLE `users` MODIFY `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL
+type ColumnBackfill struct {
+	Table      string
+	Name       string
+	Column     ColumnType // nullable definition
+	Expression string     // rendered verbatim
+	NotNull    ColumnType // optional final definition
+}
+
+// Commands returns statements to add, backfill and modify the column in order.
+// No commands return on incomplete input.
+func (b ColumnBackfill) Commands() []Command {
+	if b.Table == "" || b.Name == "" || b.Column == nil || b.Expression == "" {
+		return nil
+	}
+
+	commands := []Command{
+		alterTableCommand{b.Table, TableCommands{AddColumnCommand{Name: b.Name, Column: b.Column}}},
+		updateColumnCommand{table: b.Table, column: b.Name, expression: b.Expression},
+	}
+
+	if b.NotNull != nil {
+		commands = append(commands, alterTableCommand{b.Table, TableCommands{ModifyColumnCommand{Name: b.Name, Column: b.NotNull, Old: b.Column}}})
+	}
+
+	return commands
+}
+
+type updateColumnCommand struct {
+	table      string
+	column     string
+	expression string
+}
+
+func (c updateColumnCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c updateColumnCommand) ToSQLWithOptions(o Options) string {
+	return fmt.Sprintf("UPDATE %s SET %s = %s", o.quoteIdentifier(c.table), o.quoteIdentifier(c.column), c.expression)
+}
+
+// Reverse returns no statement, as backfilled values are dropped together with the column.
+func (c updateColumnCommand) Reverse() Command {
+	return noopCommand{}
+}
@@ -0,0 +1,98 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableBundle(t *testing.T) {
+	t.Run("it returns no commands for empty bundle", func(t *testing.T) {
+		assert.Len(t, TableBundle{}.Commands(), 0)
+	})
+
+	t.Run("it builds bundle with the same commands", func(t *testing.T) {
+		c := TableCommands{testCommand("test")}
+
+		assert.Equal(t, TableBundle{"posts": c, "comments": c}, BundleTables(c, "posts", "comments"))
+	})
+
+	t.Run("it renders alter statements ordered by table name", func(t *testing.T) {
+		c := TableCommands{AddColumnCommand{Name: "tenant_id", Column: Integer{Unsigned: true}}}
+		b := BundleTables(c, "users", "posts", "comments")
+		b["posts"] = append(TableCommands{DropColumnCommand("owner_id")}, c...)
+
+		var statements []string
+		for _, command := range b.Commands() {
+			statements = append(statements, command.ToSQL())
+		}
+
+		assert.Equal(t, []string{
+			"ALTER TABLE `comments` ADD COLUMN `tenant_id` int unsigned NOT NULL",
+			"ALTER TABLE `posts` DROP COLUMN `owner_id`, ADD COLUMN `tenant_id` int unsigned NOT NULL",
+			"ALTER TABLE `users` ADD COLUMN `tenant_id` int unsigned NOT NULL",
+		}, statements)
+	})
+}
+
+func TestColumnBackfill(t *testing.T) {
+	b := ColumnBackfill{
+		Table:      "users",
+		Name:       "full_name",
+		Column:     String{Precision: 255, Nullable: Null},
+		Expression: "CONCAT(first_name, ' ', last_name)",
+		NotNull:    String{Precision: 255},
+	}
+
+	render := func(commands []Command) []string {
+		var statements []string
+		for _, command := range commands {
+			statements = append(statements, command.ToSQL())
+		}
+
+		return statements
+	}
+
+	t.Run("it returns no commands on incomplete input", func(t *testing.T) {
+		assert.Len(t, ColumnBackfill{Table: "users", Name: "full_name", Column: String{}}.Commands(), 0)
+		assert.Len(t, ColumnBackfill{Table: "users", Name: "full_name", Expression: "1"}.Commands(), 0)
+		assert.Len(t, ColumnBackfill{Name: "full_name", Column: String{}, Expression: "1"}.Commands(), 0)
+	})
+
+	t.Run("it renders add, backfill and not null statements", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"ALTER TABLE `users` ADD COLUMN `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NULL",
+			"UPDATE `users` SET `full_name` = CONCAT(first_name, ' ', last_name)",
+			"ALTER TABLE `users` MODIFY `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL",
+		}, render(b.Commands()))
+	})
+
+	t.Run("it skips not null statement without final definition", func(t *testing.T) {
+		nullable := b
+		nullable.NotNull = nil
+
+		assert.Equal(t, []string{
+			"ALTER TABLE `users` ADD COLUMN `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NULL",
+			"UPDATE `users` SET `full_name` = CONCAT(first_name, ' ', last_name)",
+		}, render(nullable.Commands()))
+	})
+
+	t.Run("it reverses to column drop", func(t *testing.T) {
+		commands := b.Commands()
+
+		assert.Equal(
+			t,
+			"ALTER TABLE `users` MODIFY `full_name` varchar(255) COLLATE utf8mb4_unicode_ci NULL",
+			Reverse(commands[2]).ToSQL(),
+		)
+		assert.Equal(t, noopCommand{}, Reverse(commands[1]))
+		assert.Equal(t, "ALTER TABLE `users` DROP COLUMN `full_name`", Reverse(commands[0]).ToSQL())
+	})
+
+	t.Run("it adds statements to the schema", func(t *testing.T) {
+		var s Schema
+		s.BackfillColumn(b)
+
+		assert.Equal(t, b.Commands(), s.pool)
+	})
+}
@@ -8,25 +8,181 @@ import (
 
 type columns []column
 
-func (c columns) render() string {
+func (c columns) render(o Options) string {
 	rows := []string{}
 
 	for _, item := range c {
-		rows = append(rows, fmt.Sprintf("`%s` %s", item.field, item.definition.BuildRow()))
+		rows = append(rows, o.quoteIdentifier(item.field)+" "+buildRowWithOptions(item.definition, o))
 	}
 
 	return strings.Join(rows, ", ")
 }
 
+func (c columns) renderWithArgs() (string, []interface{}) {
+	rows := []string{}
+	args := []interface{}{}
+
+	for _, item := range c {
+		definition, a := buildRowWithArgs(item.definition)
+		rows = append(rows, Options{}.quoteIdentifier(item.field)+" "+definition)
+		args = append(args, a...)
+	}
+
+	return strings.Join(rows, ", "), args
+}
+
 type column struct {
 	field      string
 	definition ColumnType
 }
 
+// Nullability represents NULL or NOT NULL attribute of the column.
+// Default is NOT NULL, NullUnspecified omits the attribute to apply the engine default.
+type Nullability uint8
+
+const (
+	// NotNull builds `NOT NULL` attribute
+	NotNull Nullability = iota
+	// Null builds `NULL` attribute
+	Null
+	// NullUnspecified omits the attribute
+	NullUnspecified
+)
+
+func (n Nullability) render() string {
+	switch n {
+	case NotNull:
+		return " NOT NULL"
+	case Null:
+		return " NULL"
+	}
+
+	return ""
+}
+
+func nullabilityOf(nullable bool) Nullability {
+	if nullable {
+		return Null
+	}
+
+	return NotNull
+}
+
 type ColumnType interface {
 	BuildRow() string
 }
 
+// ParameterizedColumnType is implemented by column types able to render
+// string default values as `?` placeholders, returning the values separately.
+//
+// Warning ⚠️ MySQL does not accept parameter markers in DDL statements on the server side,
+// so it works only with drivers interpolating parameters on the client side
+// (e.g. go-sql-driver/mysql with `interpolateParams=true`).
+type ParameterizedColumnType interface {
+	BuildRowWithArgs() (string, []interface{})
+}
+
+func buildRowWithArgs(c ColumnType) (string, []interface{}) {
+	if p, ok := c.(ParameterizedColumnType); ok {
+		return p.BuildRowWithArgs()
+	}
+
+	return c.BuildRow(), nil
+}
+
+// ConfigurableColumnType is implemented by column types which rendering depends on Options.
+type ConfigurableColumnType interface {
+	BuildRowWithOptions(o Options) string
+}
+
+func buildRowWithOptions(c ColumnType, o Options) string {
+	if r, ok := c.(ConfigurableColumnType); ok {
+		return r.BuildRowWithOptions(o)
+	}
+
+	return c.BuildRow()
+}
+
+// columnInfo describes common attributes of the column type
+type columnInfo struct {
+	nullable      bool
+	def           string
+	autoincrement bool
+	generated     bool
+	invisible     bool
+}
+
+// describeColumn extracts common attributes from built-in column types
+func describeColumn(c ColumnType) (columnInfo, bool) {
+	switch v := c.(type) {
+	case Integer:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.defaultValue(Options{}), autoincrement: v.Autoincrement, invisible: v.Invisible}, true
+	case Floatable:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Timable:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case String:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Text:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case JSON:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Enum:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Bit:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Binary:
+		return columnInfo{nullable: v.Nullable != NotNull, def: v.Default, invisible: v.Invisible}, true
+	case Spatial:
+		return columnInfo{nullable: v.Nullable != NotNull, invisible: v.Invisible}, true
+	case Generated:
+		return columnInfo{nullable: v.Nullable != NotNull, generated: true, invisible: v.Invisible}, true
+	}
+
+	return columnInfo{}, false
+}
+
+// withComment returns a copy of built-in column type with the new comment, nil returns for unknown types
+func withComment(c ColumnType, comment string) ColumnType {
+	switch v := c.(type) {
+	case Integer:
+		v.Comment = comment
+		return v
+	case Floatable:
+		v.Comment = comment
+		return v
+	case Timable:
+		v.Comment = comment
+		return v
+	case String:
+		v.Comment = comment
+		return v
+	case Text:
+		v.Comment = comment
+		return v
+	case JSON:
+		v.Comment = comment
+		return v
+	case Enum:
+		v.Comment = comment
+		return v
+	case Bit:
+		v.Comment = comment
+		return v
+	case Binary:
+		v.Comment = comment
+		return v
+	case Spatial:
+		v.Comment = comment
+		return v
+	case Generated:
+		v.Comment = comment
+		return v
+	}
+
+	return nil
+}
+
 // Integer represents an integer value in DB: {tiny,small,medium,big}int
 //
 // Default migrator.Integer will build a sql row: `int NOT NULL`
@@ -34,25 +190,34 @@ type ColumnType interface {
 // Examples:
 //		tinyint		➡️ migrator.Integer{Prefix: "tiny", Unsigned: true, Precision: 1, Default: "0"}
 //			↪️ tinyint(1) unsigned NOT NULL DEFAULT 0
-//		int			➡️ migrator.Integer{Nullable: true, OnUpdate: "set null", Comment: "nullable counter"}
+//		int			➡️ migrator.Integer{Nullable: migrator.Null, OnUpdate: "set null", Comment: "nullable counter"}
 //			↪️ int NULL ON UPDATE set null COMMENT 'nullable counter'
 //		mediumint	➡️ migrator.Integer{Prefix: "medium", Precision: "255"}
 //			↪️ mediumint(255) NOT NULL
 //		bigint		➡️ migrator.Integer{Prefix: "big", Unsigned: true, Precision: "255", Autoincrement: true}
 //			↪️ bigint(255) unsigned NOT NULL AUTO_INCREMENT
 type Integer struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Prefix        string // tiny, small, medium, big
 	Unsigned      bool
 	Precision     uint16
 	Autoincrement bool
+
+	// BooleanDefault takes precedence over Default and is rendered
+	// as 1/0 or TRUE/FALSE depending on Options.BooleanLiterals
+	BooleanDefault *bool
 }
 
 func (i Integer) BuildRow() string {
+	return i.BuildRowWithOptions(Options{})
+}
+
+func (i Integer) BuildRowWithOptions(o Options) string {
 	sql := i.Prefix + "int"
 	if i.Precision > 0 {
 		sql += fmt.Sprintf("(%s)", strconv.Itoa(int(i.Precision)))
@@ -62,29 +227,24 @@ func (i Integer) BuildRow() string {
 		sql += " unsigned"
 	}
 
-	if i.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
+	sql += columnAttributes{
+		nullable:      i.Nullable,
+		def:           buildDefault(i.defaultValue(o)),
+		autoincrement: i.Autoincrement,
+		onUpdate:      i.OnUpdate,
+		comment:       i.Comment,
+		invisible:     i.Invisible,
+	}.render(o)
 
-	if i.Default != "" {
-		sql += " DEFAULT " + i.Default
-	}
-
-	if i.Autoincrement {
-		sql += " AUTO_INCREMENT"
-	}
-
-	if i.OnUpdate != "" {
-		sql += " ON UPDATE " + i.OnUpdate
-	}
+	return sql
+}
 
-	if i.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", i.Comment)
+func (i Integer) defaultValue(o Options) string {
+	if i.BooleanDefault != nil {
+		return o.booleanLiteral(*i.BooleanDefault)
 	}
 
-	return sql
+	return i.Default
 }
 
 // Floatable represents a number with a floating point in DB:
@@ -93,7 +253,7 @@ func (i Integer) BuildRow() string {
 // Default migrator.Floatable will build a sql row: `float NOT NULL`
 //
 // Examples:
-//		float	➡️ migrator.Floatable{Precision: 2, Nullable: true}
+//		float	➡️ migrator.Floatable{Precision: 2, Nullable: migrator.Null}
 //			↪️ float(2) NULL
 //		real	➡️ migrator.Floatable{Type: "real", Precision: 5, Scale: 2}
 //			↪️ real(5,2) NOT NULL
@@ -104,10 +264,11 @@ func (i Integer) BuildRow() string {
 //		numeric	➡️ migrator.Floatable{Type: "numeric", Default: "0.0"}
 //			↪️ numeric NOT NULL DEFAULT 0.0
 type Floatable struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Type      string // float, real, double, decimal, numeric
 	Unsigned  bool
@@ -116,6 +277,10 @@ type Floatable struct {
 }
 
 func (f Floatable) BuildRow() string {
+	return f.BuildRowWithOptions(Options{})
+}
+
+func (f Floatable) BuildRowWithOptions(o Options) string {
 	sql := f.Type
 
 	if sql == "" {
@@ -132,23 +297,13 @@ func (f Floatable) BuildRow() string {
 		sql += " unsigned"
 	}
 
-	if f.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
-
-	if f.Default != "" {
-		sql += " DEFAULT " + f.Default
-	}
-
-	if f.OnUpdate != "" {
-		sql += " ON UPDATE " + f.OnUpdate
-	}
-
-	if f.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", f.Comment)
-	}
+	sql += columnAttributes{
+		nullable:  f.Nullable,
+		def:       buildDefault(f.Default),
+		onUpdate:  f.OnUpdate,
+		comment:   f.Comment,
+		invisible: f.Invisible,
+	}.render(o)
 
 	return sql
 }
@@ -160,7 +315,7 @@ func (f Floatable) BuildRow() string {
 // Precision from 0 to 6 can be set for `datetime`, `timestamp`, `time`.
 //
 // Examples:
-//		date		➡️ migrator.Timable{Type: "date", Nullable: true}
+//		date		➡️ migrator.Timable{Type: "date", Nullable: migrator.Null}
 //			↪️ date NULL
 //		datetime	➡️ migrator.Timable{Type: "datetime", Precision: 3, Default: "CURRENT_TIMESTAMP"}
 //			↪️ datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
@@ -168,19 +323,24 @@ func (f Floatable) BuildRow() string {
 //			↪️ timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
 //		time		➡️ migrator.Timable{Type: "time", Comment: "meeting time"}
 //			↪️ time NOT NULL COMMENT 'meeting time'
-//		year		➡️ migrator.Timable{Type: "year", Nullable: true}
+//		year		➡️ migrator.Timable{Type: "year", Nullable: migrator.Null}
 //			↪️ year NULL
 type Timable struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Type      string // date, time, datetime, timestamp, year
 	Precision uint16
 }
 
 func (t Timable) BuildRow() string {
+	return t.BuildRowWithOptions(Options{})
+}
+
+func (t Timable) BuildRowWithOptions(o Options) string {
 	sql := t.Type
 
 	if sql == "" {
@@ -193,23 +353,13 @@ func (t Timable) BuildRow() string {
 		sql += fmt.Sprintf("(%s)", strconv.Itoa(int(t.Precision)))
 	}
 
-	if t.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
-
-	if t.Default != "" {
-		sql += " DEFAULT " + t.Default
-	}
-
-	if t.OnUpdate != "" {
-		sql += " ON UPDATE " + t.OnUpdate
-	}
-
-	if t.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", t.Comment)
-	}
+	sql += columnAttributes{
+		nullable:  t.Nullable,
+		def:       buildDefault(t.Default),
+		onUpdate:  t.OnUpdate,
+		comment:   t.Comment,
+		invisible: t.Invisible,
+	}.render(o)
 
 	return sql
 }
@@ -219,15 +369,16 @@ func (t Timable) BuildRow() string {
 // Default migrator.String will build a sql row: `varchar COLLATE utf8mb4_unicode_ci NOT NULL`
 //
 // Examples:
-//		char	➡️ migrator.String{Fixed: true, Precision: 36, Nullable: true, OnUpdate: "set null", Comment: "uuid"}
+//		char	➡️ migrator.String{Fixed: true, Precision: 36, Nullable: migrator.Null, OnUpdate: "set null", Comment: "uuid"}
 //			↪️ char(36) COLLATE utf8mb4_unicode_ci NULL ON UPDATE set null COMMENT 'uuid'
 //		varchar	➡️ migrator.String{Precision: 255, Default: "active", Charset: "utf8mb4", Collate: "utf8mb4_general_ci"}
 //			↪️ varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT 'active'
 type String struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Charset string
 	Collate string
@@ -237,6 +388,19 @@ type String struct {
 }
 
 func (s String) BuildRow() string {
+	return s.buildRow(Options{}, nil)
+}
+
+func (s String) BuildRowWithOptions(o Options) string {
+	return s.buildRow(o, nil)
+}
+
+func (s String) BuildRowWithArgs() (string, []interface{}) {
+	args := []interface{}{}
+	return s.buildRow(Options{}, &args), args
+}
+
+func (s String) buildRow(o Options, args *[]interface{}) string {
 	sql := ""
 
 	if !s.Fixed {
@@ -260,21 +424,13 @@ func (s String) BuildRow() string {
 		sql += " COLLATE utf8mb4_unicode_ci"
 	}
 
-	if s.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
-
-	sql += buildDefaultForString(s.Default)
-
-	if s.OnUpdate != "" {
-		sql += " ON UPDATE " + s.OnUpdate
-	}
-
-	if s.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", s.Comment)
-	}
+	sql += columnAttributes{
+		nullable:  s.Nullable,
+		def:       buildParameterizedDefaultForString(o, s.Default, args),
+		onUpdate:  s.OnUpdate,
+		comment:   s.Comment,
+		invisible: s.Invisible,
+	}.render(o)
 
 	return sql
 }
@@ -288,7 +444,7 @@ func (s String) BuildRow() string {
 // Examples:
 //		tinytext	➡️ migrator.Text{Prefix: "tiny"}
 //			↪️ tinytext COLLATE utf8mb4_unicode_ci NOT NULL
-//		text		➡️ migrator.Text{Nullable: true, OnUpdate: "set null", Comment: "write your comment here"}
+//		text		➡️ migrator.Text{Nullable: migrator.Null, OnUpdate: "set null", Comment: "write your comment here"}
 //			↪️ text COLLATE utf8mb4_unicode_ci NULL ON UPDATE set null COMMENT 'write your comment here'
 //		mediumtext	➡️ migrator.Text{Prefix: "medium"}
 //			↪️ mediumtext COLLATE utf8mb4_unicode_ci NOT NULL
@@ -303,10 +459,11 @@ func (s String) BuildRow() string {
 //		longblob	➡️ migrator.Text{Prefix: "long", Blob: true}
 //			↪️ longblob NOT NULL
 type Text struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Charset string
 	Collate string
@@ -316,6 +473,19 @@ type Text struct {
 }
 
 func (t Text) BuildRow() string {
+	return t.buildRow(Options{}, nil)
+}
+
+func (t Text) BuildRowWithOptions(o Options) string {
+	return t.buildRow(o, nil)
+}
+
+func (t Text) BuildRowWithArgs() (string, []interface{}) {
+	args := []interface{}{}
+	return t.buildRow(Options{}, &args), args
+}
+
+func (t Text) buildRow(o Options, args *[]interface{}) string {
 	sql := t.Prefix
 
 	if t.Blob {
@@ -324,34 +494,58 @@ func (t Text) BuildRow() string {
 		sql += "text"
 	}
 
-	if t.Charset != "" {
+	// blob types are binary already and do not accept binary character set clause
+	if t.Charset != "" && !(t.Blob && normalizeCharset(t.Charset) == "binary") {
 		sql += " CHARACTER SET " + t.Charset
 	}
 
-	if t.Collate != "" {
+	if t.Collate != "" && !(t.Blob && normalizeCharset(t.Collate) == "binary") {
 		sql += " COLLATE " + t.Collate
 	} else if t.Charset == "" && t.Blob == false {
 		// use default
 		sql += " COLLATE utf8mb4_unicode_ci"
 	}
 
-	if t.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
+	sql += columnAttributes{
+		nullable:  t.Nullable,
+		def:       buildParameterizedDefaultForString(o, t.Default, args),
+		onUpdate:  t.OnUpdate,
+		comment:   t.Comment,
+		invisible: t.Invisible,
+	}.render(o)
+
+	return sql
+}
+
+// validateCharset checks that character set is not used for blob types, except the binary one, which is omitted on render,
+// and the collation belongs to the character set. The utf8 alias is treated as utf8mb3.
+func (t Text) validateCharset() error {
+	charset := normalizeCharset(t.Charset)
+	collate := normalizeCharset(t.Collate)
+
+	if t.Blob && (charset != "" && charset != "binary" || collate != "" && collate != "binary") {
+		return ErrBinaryCharset
 	}
 
-	sql += buildDefaultForString(t.Default)
+	if charset == "" || collate == "" {
+		return nil
+	}
 
-	if t.OnUpdate != "" {
-		sql += " ON UPDATE " + t.OnUpdate
+	if collate != charset && !strings.HasPrefix(collate, charset+"_") {
+		return fmt.Errorf("%s for %s: %w", t.Collate, t.Charset, ErrCollationMismatch)
 	}
 
-	if t.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", t.Comment)
+	return nil
+}
+
+// normalizeCharset lowercases the character set or collation name, replacing utf8 alias with utf8mb3
+func normalizeCharset(name string) string {
+	name = strings.ToLower(name)
+	if name == "utf8" || strings.HasPrefix(name, "utf8_") {
+		return "utf8mb3" + name[len("utf8"):]
 	}
 
-	return sql
+	return name
 }
 
 // JSON represents DB column type `json`
@@ -359,35 +553,41 @@ func (t Text) BuildRow() string {
 // Default migrator.JSON will build a sql row: `json NOT NULL`
 //
 // Examples:
-//		➡️ migrator.JSON{Nullable: true, Comment: "user data"}
+//		➡️ migrator.JSON{Nullable: migrator.Null, Comment: "user data"}
 //			↪️ json NULL COMMENT 'user data'
 //		➡️ migrator.JSON{Default: "{}", OnUpdate: "{}"}
 //			↪️ json NOT NULL DEFAULT '{}' ON UPDATE {}
 type JSON struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 }
 
 func (j JSON) BuildRow() string {
-	sql := "json"
+	return j.buildRow(Options{}, nil)
+}
 
-	if j.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
+func (j JSON) BuildRowWithOptions(o Options) string {
+	return j.buildRow(o, nil)
+}
 
-	sql += buildDefaultForString(j.Default)
+func (j JSON) BuildRowWithArgs() (string, []interface{}) {
+	args := []interface{}{}
+	return j.buildRow(Options{}, &args), args
+}
 
-	if j.OnUpdate != "" {
-		sql += " ON UPDATE " + j.OnUpdate
-	}
+func (j JSON) buildRow(o Options, args *[]interface{}) string {
+	sql := "json"
 
-	if j.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", j.Comment)
-	}
+	sql += columnAttributes{
+		nullable:  j.Nullable,
+		def:       buildParameterizedDefaultForString(o, j.Default, args),
+		onUpdate:  j.OnUpdate,
+		comment:   j.Comment,
+		invisible: j.Invisible,
+	}.render(o)
 
 	return sql
 }
@@ -397,21 +597,35 @@ func (j JSON) BuildRow() string {
 // Default migrator.Enum will build a sql row: `enum('') NOT NULL`
 //
 // Examples:
-//		enum	➡️ migrator.Enum{Values: []string{"on", "off"}, Default: "off", Nullable: true, OnUpdate: "set null"}
+//		enum	➡️ migrator.Enum{Values: []string{"on", "off"}, Default: "off", Nullable: migrator.Null, OnUpdate: "set null"}
 //			↪️ enum('on', 'off') NULL DEFAULT 'off' ON UPDATE set null
 //		set		➡️ migrator.Enum{Values: []string{"1", "2", "3"}, Comment: "options"}
 //			↪️ set('1', '2', '3') NOT NULL COMMENT 'options'
 type Enum struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Values   []string
 	Multiple bool // "set", otherwise "enum"
 }
 
 func (e Enum) BuildRow() string {
+	return e.buildRow(Options{}, nil)
+}
+
+func (e Enum) BuildRowWithOptions(o Options) string {
+	return e.buildRow(o, nil)
+}
+
+func (e Enum) BuildRowWithArgs() (string, []interface{}) {
+	args := []interface{}{}
+	return e.buildRow(Options{}, &args), args
+}
+
+func (e Enum) buildRow(o Options, args *[]interface{}) string {
 	sql := ""
 
 	if e.Multiple {
@@ -420,25 +634,41 @@ func (e Enum) BuildRow() string {
 		sql += "enum"
 	}
 
-	sql += "('" + strings.Join(e.Values, "', '") + "')"
-
-	if e.Nullable {
-		sql += " NULL"
+	if len(e.Values) == 0 {
+		sql += "('')"
 	} else {
-		sql += " NOT NULL"
+		sql += "(" + o.quoteStrings(e.Values) + ")"
 	}
 
-	sql += buildDefaultForString(e.Default)
+	sql += columnAttributes{
+		nullable:  e.Nullable,
+		def:       buildParameterizedDefaultForString(o, e.Default, args),
+		onUpdate:  e.OnUpdate,
+		comment:   e.Comment,
+		invisible: e.Invisible,
+	}.render(o)
+
+	return sql
+}
 
-	if e.OnUpdate != "" {
-		sql += " ON UPDATE " + e.OnUpdate
+// storageSize returns the number of bytes used to store the value:
+// enum takes 1 or 2 bytes, set takes 1, 2, 3, 4 or 8 bytes depending on the number of members.
+func (e Enum) storageSize() int {
+	n := len(e.Values)
+
+	if !e.Multiple {
+		if n > 255 {
+			return 2
+		}
+
+		return 1
 	}
 
-	if e.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", e.Comment)
+	if n > 32 {
+		return 8
 	}
 
-	return sql
+	return (n + 7) / 8
 }
 
 // Bit represents default `bit` column type
@@ -448,41 +678,36 @@ func (e Enum) BuildRow() string {
 // Examples:
 //		➡️ migrator.Bit{Precision: 8, Default: "1", Comment: "mario game code"}
 //			↪️ bit(8) NOT NULL DEFAULT 1 COMMENT 'mario game code'
-//		➡️ migrator.Bit{Precision: 64, Nullable: true, OnUpdate: "set null"}
+//		➡️ migrator.Bit{Precision: 64, Nullable: migrator.Null, OnUpdate: "set null"}
 //			↪️ bit(64) NULL ON UPDATE set null
 type Bit struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Precision uint16
 }
 
 func (b Bit) BuildRow() string {
+	return b.BuildRowWithOptions(Options{})
+}
+
+func (b Bit) BuildRowWithOptions(o Options) string {
 	sql := "bit"
 
 	if b.Precision > 0 {
 		sql += "(" + strconv.Itoa(int(b.Precision)) + ")"
 	}
 
-	if b.Nullable {
-		sql += " NULL"
-	} else {
-		sql += " NOT NULL"
-	}
-
-	if b.Default != "" {
-		sql += " DEFAULT " + b.Default
-	}
-
-	if b.OnUpdate != "" {
-		sql += " ON UPDATE " + b.OnUpdate
-	}
-
-	if b.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", b.Comment)
-	}
+	sql += columnAttributes{
+		nullable:  b.Nullable,
+		def:       buildDefault(b.Default),
+		onUpdate:  b.OnUpdate,
+		comment:   b.Comment,
+		invisible: b.Invisible,
+	}.render(o)
 
 	return sql
 }
@@ -494,19 +719,24 @@ func (b Bit) BuildRow() string {
 // Examples:
 //		binary		➡️ migrator.Binary{Fixed: true, Precision: 36, Default: "1", Comment: "uuid"}
 //			↪️ binary(36) NOT NULL DEFAULT 1 COMMENT 'uuid'
-//		varbinary	➡️ migrator.Binary{Precision: 255, Nullable: true, OnUpdate: "set null"}
+//		varbinary	➡️ migrator.Binary{Precision: 255, Nullable: migrator.Null, OnUpdate: "set null"}
 //			↪️ varbinary(255) NULL ON UPDATE set null
 type Binary struct {
-	Default  string
-	Nullable bool
-	Comment  string
-	OnUpdate string
+	Default   string
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+	OnUpdate  string
 
 	Fixed     bool // binary for fixed, otherwise varbinary
 	Precision uint16
 }
 
 func (b Binary) BuildRow() string {
+	return b.BuildRowWithOptions(Options{})
+}
+
+func (b Binary) BuildRowWithOptions(o Options) string {
 	sql := ""
 
 	if !b.Fixed {
@@ -519,33 +749,227 @@ func (b Binary) BuildRow() string {
 		sql += fmt.Sprintf("(%s)", strconv.Itoa(int(b.Precision)))
 	}
 
-	if b.Nullable {
-		sql += " NULL"
+	sql += columnAttributes{
+		nullable:  b.Nullable,
+		def:       buildDefault(b.Default),
+		onUpdate:  b.OnUpdate,
+		comment:   b.Comment,
+		invisible: b.Invisible,
+	}.render(o)
+
+	return sql
+}
+
+// Spatial represents spatial column type: `geometry`, `point`, `linestring`, `polygon`,
+// `multipoint`, `multilinestring`, `multipolygon` or `geometrycollection`
+//
+// Default migrator.Spatial will build a sql row: `geometry NOT NULL`.
+// SRID restricts the column to the values with given spatial reference system, it is ignored if not numeric.
+//
+// Examples:
+//		point	➡️ migrator.Spatial{Type: "point", SRID: "4326"}
+//			↪️ point NOT NULL SRID 4326
+//		polygon	➡️ migrator.Spatial{Type: "polygon", Nullable: migrator.Null, Comment: "area"}
+//			↪️ polygon NULL COMMENT 'area'
+type Spatial struct {
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+
+	Type string
+	SRID string
+}
+
+var spatialTypes = list{"geometry", "point", "linestring", "polygon", "multipoint", "multilinestring", "multipolygon", "geometrycollection"}
+
+func (s Spatial) BuildRow() string {
+	return s.BuildRowWithOptions(Options{})
+}
+
+func (s Spatial) BuildRowWithOptions(o Options) string {
+	sql := strings.ToLower(s.Type)
+
+	if !spatialTypes.has(sql) {
+		sql = "geometry"
+	}
+
+	sql += columnAttributes{
+		nullable:  s.Nullable,
+		srid:      s.SRID,
+		comment:   s.Comment,
+		invisible: s.Invisible,
+	}.render(o)
+
+	return sql
+}
+
+func isNumeric(v string) bool {
+	if v == "" {
+		return false
+	}
+
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Generated represents a generated (computed) column, which value is calculated from the expression.
+//
+// Default migrator.Generated will build an empty row, Type and Expression are required.
+// Stored columns are rendered with `STORED` keyword for MySQL and `PERSISTENT` for MariaDB.
+// Clauses follow MySQL order: generation expression, storage, nullability, comment.
+//
+// Examples:
+//		virtual	➡️ migrator.Generated{Type: "int", Expression: "a + b"}
+//			↪️ int AS (a + b) VIRTUAL NOT NULL
+//		stored	➡️ migrator.Generated{Type: "varchar(255)", Expression: "CONCAT(first, ' ', last)", Stored: true, Nullable: migrator.Null}
+//			↪️ varchar(255) AS (CONCAT(first, ' ', last)) STORED NULL
+//		mariadb	➡️ migrator.Generated{Type: "int", Expression: "a + b", Stored: true, Dialect: migrator.MariaDB}
+//			↪️ int AS (a + b) PERSISTENT NOT NULL
+//		collated	➡️ migrator.Generated{Type: "varchar(255)", Expression: "LOWER(email)", Collate: "utf8mb4_0900_ai_ci"}
+//			↪️ varchar(255) COLLATE utf8mb4_0900_ai_ci AS (LOWER(email)) VIRTUAL NOT NULL
+//		commented	➡️ migrator.Generated{Type: "int", Expression: "a + b", Stored: true, Comment: "derived"}
+//			↪️ int AS (a + b) STORED NOT NULL COMMENT 'derived'
+type Generated struct {
+	Nullable  Nullability
+	Comment   string
+	Invisible bool
+
+	Charset string
+	Collate string
+
+	Type       string
+	Expression string
+	Stored     bool
+	Dialect    Dialect
+}
+
+func (g Generated) BuildRow() string {
+	return g.BuildRowWithOptions(Options{})
+}
+
+func (g Generated) BuildRowWithOptions(o Options) string {
+	if g.Type == "" || g.Expression == "" {
+		return ""
+	}
+
+	sql := g.Type
+
+	if g.Charset != "" {
+		sql += " CHARACTER SET " + g.Charset
+	}
+
+	if g.Collate != "" {
+		sql += " COLLATE " + g.Collate
+	}
+
+	sql += " AS (" + g.Expression + ")"
+
+	if !g.Stored {
+		sql += " VIRTUAL"
+	} else if g.dialect(o) == MariaDB {
+		sql += " PERSISTENT"
 	} else {
-		sql += " NOT NULL"
+		sql += " STORED"
 	}
 
-	if b.Default != "" {
-		sql += " DEFAULT " + b.Default
+	sql += columnAttributes{
+		nullable:  g.Nullable,
+		comment:   g.Comment,
+		invisible: g.Invisible,
+	}.render(o)
+
+	return sql
+}
+
+// dialect returns the dialect of the column, falling back to the one from options
+func (g Generated) dialect(o Options) Dialect {
+	if g.Dialect != "" {
+		return g.Dialect
 	}
 
-	if b.OnUpdate != "" {
-		sql += " ON UPDATE " + b.OnUpdate
+	return o.Dialect
+}
+
+// columnAttributes are common attributes rendered after the data type of any column type.
+// Attributes are always rendered in the canonical order:
+// NOT NULL | NULL, DEFAULT, AUTO_INCREMENT, ON UPDATE, SRID, COMMENT, INVISIBLE.
+type columnAttributes struct {
+	nullable      Nullability
+	def           string // rendered DEFAULT clause
+	autoincrement bool
+	onUpdate      string
+	srid          string // ignored if not numeric
+	comment       string
+	invisible     bool
+}
+
+func (a columnAttributes) render(o Options) string {
+	sql := a.nullable.render()
+
+	sql += a.def
+
+	if a.autoincrement {
+		sql += " AUTO_INCREMENT"
+	}
+
+	if a.onUpdate != "" {
+		sql += " ON UPDATE " + a.onUpdate
+	}
+
+	if isNumeric(a.srid) {
+		sql += " SRID " + a.srid
 	}
 
-	if b.Comment != "" {
-		sql += fmt.Sprintf(" COMMENT '%s'", b.Comment)
+	if a.comment != "" {
+		sql += " COMMENT " + o.quoteString(a.comment)
+	}
+
+	if a.invisible {
+		sql += " INVISIBLE"
 	}
 
 	return sql
 }
 
-func buildDefaultForString(v string) string {
+func buildDefault(v string) string {
+	if v == "" {
+		return ""
+	}
+
+	return " DEFAULT " + v
+}
+
+// buildParameterizedDefaultForString replaces string literal with `?` placeholder
+// and collects the value into args. Behaves like buildDefaultForString when args is nil.
+func buildParameterizedDefaultForString(o Options, v string, args *[]interface{}) string {
+	if args == nil || v == "" || isDefaultExpression(v) {
+		return buildDefaultForString(o, v)
+	}
+
+	if v == "<empty>" || v == "<nil>" {
+		v = ""
+	}
+
+	*args = append(*args, v)
+
+	return " DEFAULT ?"
+}
+
+func isDefaultExpression(v string) bool {
+	return v[:1] == "(" && v[len(v)-1:] == ")"
+}
+
+func buildDefaultForString(o Options, v string) string {
 	if v == "" {
 		return ""
 	}
 
-	if v[:1] == "(" && v[len(v)-1:] == ")" {
+	if isDefaultExpression(v) {
 		return fmt.Sprintf(" DEFAULT %s", v)
 	}
 
@@ -553,5 +977,5 @@ func buildDefaultForString(v string) string {
 		v = ""
 	}
 
-	return fmt.Sprintf(" DEFAULT '%s'", v)
+	return " DEFAULT " + o.quoteString(v)
 }
@@ -1,6 +1,7 @@
 package migrator
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,7 +17,7 @@ func TestColumnRender(t *testing.T) {
 	t.Run("it renders row from one column", func(t *testing.T) {
 		c := columns{column{"test", testColumnType("run")}}
 
-		assert.Equal(t, "`test` run", c.render())
+		assert.Equal(t, "`test` run", c.render(Options{}))
 	})
 
 	t.Run("it renders row from multiple columns", func(t *testing.T) {
@@ -25,7 +26,93 @@ func TestColumnRender(t *testing.T) {
 			column{"again", testColumnType("me")},
 		}
 
-		assert.Equal(t, "`test` run, `again` me", c.render())
+		assert.Equal(t, "`test` run, `again` me", c.render(Options{}))
+	})
+}
+
+func TestNullability(t *testing.T) {
+	t.Run("it builds NOT NULL by default", func(t *testing.T) {
+		c := Integer{}
+		assert.Equal(t, "int NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds explicit NOT NULL", func(t *testing.T) {
+		c := String{Precision: 8, Nullable: NotNull}
+		assert.Equal(t, "varchar(8) COLLATE utf8mb4_unicode_ci NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds explicit NULL", func(t *testing.T) {
+		c := Timable{Nullable: Null}
+		assert.Equal(t, "timestamp NULL", c.BuildRow())
+	})
+
+	t.Run("it omits unspecified nullability", func(t *testing.T) {
+		c := Integer{Nullable: NullUnspecified, Default: "0"}
+		assert.Equal(t, "int DEFAULT 0", c.BuildRow())
+	})
+
+	t.Run("it converts boolean flag", func(t *testing.T) {
+		assert.Equal(t, Null, nullabilityOf(true))
+		assert.Equal(t, NotNull, nullabilityOf(false))
+	})
+}
+
+func TestColumnAttributes(t *testing.T) {
+	t.Run("it renders only nullability by default", func(t *testing.T) {
+		assert.Equal(t, " NOT NULL", columnAttributes{}.render(Options{}))
+	})
+
+	t.Run("it renders every attribute in canonical order", func(t *testing.T) {
+		a := columnAttributes{
+			invisible:     true,
+			comment:       "test",
+			srid:          "4326",
+			onUpdate:      "CURRENT_TIMESTAMP",
+			autoincrement: true,
+			def:           buildDefault("0"),
+			nullable:      Null,
+		}
+
+		assert.Equal(t, " NULL DEFAULT 0 AUTO_INCREMENT ON UPDATE CURRENT_TIMESTAMP SRID 4326 COMMENT 'test' INVISIBLE", a.render(Options{}))
+	})
+
+	t.Run("it skips non-numeric SRID", func(t *testing.T) {
+		assert.Equal(t, " NULL", columnAttributes{nullable: Null, srid: "wgs84"}.render(Options{}))
+	})
+
+	t.Run("it renders integer with every attribute in canonical order", func(t *testing.T) {
+		c := Integer{
+			Invisible:     true,
+			Comment:       "counter",
+			OnUpdate:      "0",
+			Autoincrement: true,
+			Default:       "1",
+			Nullable:      NullUnspecified,
+			Unsigned:      true,
+			Precision:     11,
+			Prefix:        "big",
+		}
+
+		assert.Equal(t, "bigint(11) unsigned DEFAULT 1 AUTO_INCREMENT ON UPDATE 0 COMMENT 'counter' INVISIBLE", c.BuildRow())
+	})
+
+	t.Run("it renders string with every attribute in canonical order", func(t *testing.T) {
+		c := String{
+			Invisible: true,
+			Comment:   "name",
+			OnUpdate:  "''",
+			Default:   "guest",
+			Nullable:  Null,
+			Collate:   "utf8mb4_bin",
+			Charset:   "utf8mb4",
+			Precision: 64,
+		}
+
+		assert.Equal(
+			t,
+			"varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL DEFAULT 'guest' ON UPDATE '' COMMENT 'name' INVISIBLE",
+			c.BuildRow(),
+		)
 	})
 }
 
@@ -51,7 +138,7 @@ func TestInteger(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Integer{Nullable: true}
+		c := Integer{Nullable: Null}
 		assert.Equal(t, "int NULL", c.BuildRow())
 	})
 
@@ -75,12 +162,17 @@ func TestInteger(t *testing.T) {
 		assert.Equal(t, "int NOT NULL COMMENT 'test'", c.BuildRow())
 	})
 
+	t.Run("it builds invisible column after default", func(t *testing.T) {
+		c := Integer{Default: "0", Invisible: true}
+		assert.Equal(t, "int NOT NULL DEFAULT 0 INVISIBLE", c.BuildRow())
+	})
+
 	t.Run("it builds string with all parameters", func(t *testing.T) {
 		c := Integer{
 			Prefix:        "big",
 			Precision:     10,
 			Unsigned:      true,
-			Nullable:      true,
+			Nullable:      Null,
 			Default:       "100",
 			Autoincrement: true,
 			OnUpdate:      "set null",
@@ -122,7 +214,7 @@ func TestFloatable(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Floatable{Nullable: true}
+		c := Floatable{Nullable: Null}
 		assert.Equal(t, "float NULL", c.BuildRow())
 	})
 
@@ -147,7 +239,7 @@ func TestFloatable(t *testing.T) {
 			Precision: 10,
 			Scale:     2,
 			Unsigned:  true,
-			Nullable:  true,
+			Nullable:  Null,
 			Default:   "100.0",
 			OnUpdate:  "set null",
 			Comment:   "test",
@@ -193,7 +285,7 @@ func TestTimeable(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Timable{Nullable: true}
+		c := Timable{Nullable: Null}
 		assert.Equal(t, "timestamp NULL", c.BuildRow())
 	})
 
@@ -215,7 +307,7 @@ func TestTimeable(t *testing.T) {
 	t.Run("it builds string with all parameters", func(t *testing.T) {
 		c := Timable{
 			Type:     "datetime",
-			Nullable: true,
+			Nullable: Null,
 			Default:  "CURRENT_TIMESTAMP",
 			OnUpdate: "CURRENT_TIMESTAMP",
 			Comment:  "test",
@@ -256,7 +348,7 @@ func TestString(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := String{Nullable: true}
+		c := String{Nullable: Null}
 		assert.Equal(t, "varchar COLLATE utf8mb4_unicode_ci NULL", c.BuildRow())
 	})
 
@@ -279,7 +371,7 @@ func TestString(t *testing.T) {
 		c := String{
 			Fixed:     true,
 			Precision: 36,
-			Nullable:  true,
+			Nullable:  Null,
 			Charset:   "utf8mb4",
 			Collate:   "utf8mb4_general_ci",
 			Default:   "nice",
@@ -316,6 +408,11 @@ func TestText(t *testing.T) {
 		assert.Equal(t, "tinyblob NOT NULL", c.BuildRow())
 	})
 
+	t.Run("it omits binary charset for blob", func(t *testing.T) {
+		c := Text{Blob: true, Charset: "binary", Collate: "binary"}
+		assert.Equal(t, "blob NOT NULL", c.BuildRow())
+	})
+
 	t.Run("it builds with charset", func(t *testing.T) {
 		c := Text{Charset: "utf8"}
 		assert.Equal(t, "text CHARACTER SET utf8 NOT NULL", c.BuildRow())
@@ -327,7 +424,7 @@ func TestText(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Text{Nullable: true}
+		c := Text{Nullable: Null}
 		assert.Equal(t, "text COLLATE utf8mb4_unicode_ci NULL", c.BuildRow())
 	})
 
@@ -350,7 +447,7 @@ func TestText(t *testing.T) {
 		c := Text{
 			Prefix:   "long",
 			Blob:     true,
-			Nullable: true,
+			Nullable: Null,
 			Charset:  "utf8mb4",
 			Collate:  "utf8mb4_general_ci",
 			Default:  "nice",
@@ -364,6 +461,36 @@ func TestText(t *testing.T) {
 			c.BuildRow(),
 		)
 	})
+
+	t.Run("it builds text with character set for full-text search", func(t *testing.T) {
+		c := Text{Charset: "utf8mb4", Collate: "utf8mb4_unicode_ci"}
+		assert.Equal(t, "text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL", c.BuildRow())
+		assert.Nil(t, c.validateCharset())
+	})
+
+	t.Run("it validates character set is not set for blob", func(t *testing.T) {
+		assert.Equal(t, ErrBinaryCharset, Text{Blob: true, Charset: "utf8mb4"}.validateCharset())
+		assert.Equal(t, ErrBinaryCharset, Text{Blob: true, Collate: "utf8mb4_bin"}.validateCharset())
+		assert.Nil(t, Text{Blob: true}.validateCharset())
+		assert.Nil(t, Text{Blob: true, Charset: "binary", Collate: "binary"}.validateCharset())
+	})
+
+	t.Run("it validates collation belongs to character set", func(t *testing.T) {
+		err := Text{Charset: "latin1", Collate: "utf8mb4_unicode_ci"}.validateCharset()
+		assert.True(t, errors.Is(err, ErrCollationMismatch))
+		assert.Nil(t, Text{Charset: "UTF8MB4", Collate: "utf8mb4_0900_ai_ci"}.validateCharset())
+		assert.Nil(t, Text{Charset: "utf8mb4"}.validateCharset())
+		assert.Nil(t, Text{Charset: "binary", Collate: "binary"}.validateCharset())
+	})
+
+	t.Run("it treats utf8 as alias of utf8mb3", func(t *testing.T) {
+		assert.Nil(t, Text{Charset: "utf8", Collate: "utf8mb3_general_ci"}.validateCharset())
+		assert.Nil(t, Text{Charset: "utf8mb3", Collate: "utf8_unicode_ci"}.validateCharset())
+		assert.Nil(t, Text{Charset: "UTF8", Collate: "utf8_bin"}.validateCharset())
+
+		err := Text{Charset: "utf8", Collate: "utf8mb4_unicode_ci"}.validateCharset()
+		assert.True(t, errors.Is(err, ErrCollationMismatch))
+	})
 }
 
 func TestJson(t *testing.T) {
@@ -373,7 +500,7 @@ func TestJson(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := JSON{Nullable: true}
+		c := JSON{Nullable: Null}
 		assert.Equal(t, "json NULL", c.BuildRow())
 	})
 
@@ -394,7 +521,7 @@ func TestJson(t *testing.T) {
 
 	t.Run("it builds string with all parameters", func(t *testing.T) {
 		c := JSON{
-			Nullable: true,
+			Nullable: Null,
 			Default:  "{}",
 			OnUpdate: "set null",
 			Comment:  "test",
@@ -425,7 +552,7 @@ func TestEnum(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Enum{Nullable: true}
+		c := Enum{Nullable: Null}
 		assert.Equal(t, "enum('') NULL", c.BuildRow())
 	})
 
@@ -448,7 +575,7 @@ func TestEnum(t *testing.T) {
 		c := Enum{
 			Multiple: true,
 			Values:   []string{"male", "female", "other"},
-			Nullable: true,
+			Nullable: Null,
 			Default:  "male,female",
 			OnUpdate: "set null",
 			Comment:  "test",
@@ -474,7 +601,7 @@ func TestBit(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Bit{Nullable: true}
+		c := Bit{Nullable: Null}
 		assert.Equal(t, "bit NULL", c.BuildRow())
 	})
 
@@ -496,7 +623,7 @@ func TestBit(t *testing.T) {
 	t.Run("it builds string with all parameters", func(t *testing.T) {
 		c := Bit{
 			Precision: 10,
-			Nullable:  true,
+			Nullable:  Null,
 			Default:   "0",
 			OnUpdate:  "set null",
 			Comment:   "test",
@@ -527,7 +654,7 @@ func TestBinary(t *testing.T) {
 	})
 
 	t.Run("it builds nullable column type", func(t *testing.T) {
-		c := Binary{Nullable: true}
+		c := Binary{Nullable: Null}
 		assert.Equal(t, "varbinary NULL", c.BuildRow())
 	})
 
@@ -550,7 +677,7 @@ func TestBinary(t *testing.T) {
 		c := Binary{
 			Fixed:     true,
 			Precision: 36,
-			Nullable:  true,
+			Nullable:  Null,
 			Default:   "1",
 			OnUpdate:  "set null",
 			Comment:   "test",
@@ -564,36 +691,205 @@ func TestBinary(t *testing.T) {
 	})
 }
 
+func TestSpatial(t *testing.T) {
+	t.Run("it builds basic column type", func(t *testing.T) {
+		c := Spatial{}
+		assert.Equal(t, "geometry NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it falls back to geometry on invalid type", func(t *testing.T) {
+		c := Spatial{Type: "circle"}
+		assert.Equal(t, "geometry NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds with type", func(t *testing.T) {
+		c := Spatial{Type: "POINT"}
+		assert.Equal(t, "point NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds nullable column type", func(t *testing.T) {
+		c := Spatial{Type: "polygon", Nullable: Null}
+		assert.Equal(t, "polygon NULL", c.BuildRow())
+	})
+
+	t.Run("it builds with srid", func(t *testing.T) {
+		c := Spatial{Type: "point", SRID: "4326"}
+		assert.Equal(t, "point NOT NULL SRID 4326", c.BuildRow())
+	})
+
+	t.Run("it skips invalid srid", func(t *testing.T) {
+		c := Spatial{Type: "point", SRID: "wgs84"}
+		assert.Equal(t, "point NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds with all parameters", func(t *testing.T) {
+		c := Spatial{Type: "multipolygon", SRID: "0", Nullable: Null, Comment: "area"}
+		assert.Equal(t, "multipolygon NULL SRID 0 COMMENT 'area'", c.BuildRow())
+	})
+}
+
+func TestGenerated(t *testing.T) {
+	t.Run("it returns empty row without type", func(t *testing.T) {
+		c := Generated{Expression: "a + b"}
+		assert.Equal(t, "", c.BuildRow())
+	})
+
+	t.Run("it returns empty row without expression", func(t *testing.T) {
+		c := Generated{Type: "int"}
+		assert.Equal(t, "", c.BuildRow())
+	})
+
+	t.Run("it builds virtual column", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b"}
+		assert.Equal(t, "int AS (a + b) VIRTUAL NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds stored column for mysql", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b", Stored: true}
+		assert.Equal(t, "int AS (a + b) STORED NOT NULL", c.BuildRow())
+
+		c.Dialect = MySQL
+		assert.Equal(t, "int AS (a + b) STORED NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds persistent column for mariadb", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b", Stored: true, Dialect: MariaDB}
+		assert.Equal(t, "int AS (a + b) PERSISTENT NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds virtual column for mariadb", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b", Dialect: MariaDB}
+		assert.Equal(t, "int AS (a + b) VIRTUAL NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it takes dialect from options", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b", Stored: true}
+		assert.Equal(t, "int AS (a + b) PERSISTENT NOT NULL", c.BuildRowWithOptions(Options{Dialect: MariaDB}))
+	})
+
+	t.Run("it prefers column dialect over options", func(t *testing.T) {
+		c := Generated{Type: "int", Expression: "a + b", Stored: true, Dialect: MySQL}
+		assert.Equal(t, "int AS (a + b) STORED NOT NULL", c.BuildRowWithOptions(Options{Dialect: MariaDB}))
+	})
+
+	t.Run("it builds collation before expression", func(t *testing.T) {
+		c := Generated{Type: "varchar(255)", Expression: "LOWER(email)", Charset: "utf8mb4", Collate: "utf8mb4_0900_ai_ci"}
+		assert.Equal(t, "varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci AS (LOWER(email)) VIRTUAL NOT NULL", c.BuildRow())
+	})
+
+	t.Run("it builds nullable column with comment", func(t *testing.T) {
+		c := Generated{Type: "varchar(255)", Expression: "CONCAT(first, ' ', last)", Stored: true, Nullable: Null, Comment: "full name"}
+		assert.Equal(t, "varchar(255) AS (CONCAT(first, ' ', last)) STORED NULL COMMENT 'full name'", c.BuildRow())
+	})
+
+	t.Run("it builds stored column with comment in valid clause order", func(t *testing.T) {
+		c := Generated{Type: "INT", Expression: "a+b", Stored: true, Comment: "derived"}
+		assert.Equal(t, "INT AS (a+b) STORED NOT NULL COMMENT 'derived'", c.BuildRow())
+	})
+
+	t.Run("it adds column with generated expression and comment", func(t *testing.T) {
+		c := AddColumnCommand{
+			Name:   "total",
+			Column: Generated{Type: "int", Expression: "a+b", Stored: true, Nullable: Null, Comment: "derived", Invisible: true},
+			After:  "b",
+		}
+		assert.Equal(t, "ADD COLUMN `total` int AS (a+b) STORED NULL COMMENT 'derived' INVISIBLE AFTER `b`", c.ToSQL())
+	})
+}
+
+func TestInvisibleColumns(t *testing.T) {
+	t.Run("it renders invisible after default for every column type", func(t *testing.T) {
+		cases := map[string]ColumnType{
+			"float NOT NULL DEFAULT 0.0 INVISIBLE":                                   Floatable{Default: "0.0", Invisible: true},
+			"timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP INVISIBLE":                 Timable{Default: "CURRENT_TIMESTAMP", Invisible: true},
+			"varchar(8) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'new' INVISIBLE": String{Precision: 8, Default: "new", Invisible: true},
+			"text COLLATE utf8mb4_unicode_ci NULL DEFAULT '' INVISIBLE":              Text{Nullable: Null, Default: "<empty>", Invisible: true},
+			"json NOT NULL DEFAULT (JSON_OBJECT()) INVISIBLE":                        JSON{Default: "(JSON_OBJECT())", Invisible: true},
+			"enum('on', 'off') NOT NULL DEFAULT 'off' INVISIBLE":                     Enum{Values: []string{"on", "off"}, Default: "off", Invisible: true},
+			"bit(1) NOT NULL DEFAULT 0 INVISIBLE":                                    Bit{Precision: 1, Default: "0", Invisible: true},
+			"binary(16) NOT NULL DEFAULT (UUID_TO_BIN(UUID())) INVISIBLE":            Binary{Fixed: true, Precision: 16, Default: "(UUID_TO_BIN(UUID()))", Invisible: true},
+			"point NULL INVISIBLE":                                                   Spatial{Type: "point", Nullable: Null, Invisible: true},
+			"int AS (a + b) VIRTUAL NOT NULL INVISIBLE":                              Generated{Type: "int", Expression: "a + b", Invisible: true},
+		}
+
+		for expected, c := range cases {
+			assert.Equal(t, expected, c.BuildRow())
+		}
+	})
+
+	t.Run("it renders invisible after comment", func(t *testing.T) {
+		c := Integer{Default: "0", Comment: "hidden", Invisible: true}
+		assert.Equal(t, "int NOT NULL DEFAULT 0 COMMENT 'hidden' INVISIBLE", c.BuildRow())
+	})
+}
+
+func TestBuildRowWithArgs(t *testing.T) {
+	t.Run("it collects string default as placeholder", func(t *testing.T) {
+		sql, args := String{Precision: 255, Default: "active"}.BuildRowWithArgs()
+
+		assert.Equal(t, "varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?", sql)
+		assert.Equal(t, []interface{}{"active"}, args)
+	})
+
+	t.Run("it collects empty string default as placeholder", func(t *testing.T) {
+		sql, args := Text{Default: "<empty>"}.BuildRowWithArgs()
+
+		assert.Equal(t, "text COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?", sql)
+		assert.Equal(t, []interface{}{""}, args)
+	})
+
+	t.Run("it keeps expression default inline", func(t *testing.T) {
+		sql, args := JSON{Default: "(JSON_ARRAY())"}.BuildRowWithArgs()
+
+		assert.Equal(t, "json NOT NULL DEFAULT (JSON_ARRAY())", sql)
+		assert.Equal(t, []interface{}{}, args)
+	})
+
+	t.Run("it collects enum default", func(t *testing.T) {
+		sql, args := Enum{Values: []string{"on", "off"}, Default: "off"}.BuildRowWithArgs()
+
+		assert.Equal(t, "enum('on', 'off') NOT NULL DEFAULT ?", sql)
+		assert.Equal(t, []interface{}{"off"}, args)
+	})
+
+	t.Run("it falls back to plain row for other column types", func(t *testing.T) {
+		sql, args := buildRowWithArgs(Integer{Default: "0"})
+
+		assert.Equal(t, "int NOT NULL DEFAULT 0", sql)
+		assert.Nil(t, args)
+	})
+}
+
 func TestBuildDefaultForString(t *testing.T) {
 	t.Run("it returns an empty string if default value is missing", func(t *testing.T) {
-		got := buildDefaultForString("")
+		got := buildDefaultForString(Options{}, "")
 
 		assert.Equal(t, "", got)
 	})
 
 	t.Run("it builds default with expression", func(t *testing.T) {
-		got := buildDefaultForString("(UUID())")
+		got := buildDefaultForString(Options{}, "(UUID())")
 		want := " DEFAULT (UUID())"
 
 		assert.Equal(t, want, got)
 	})
 
 	t.Run("it builds default with empty string for <empty> value", func(t *testing.T) {
-		got := buildDefaultForString("<empty>")
+		got := buildDefaultForString(Options{}, "<empty>")
 		want := " DEFAULT ''"
 
 		assert.Equal(t, want, got)
 	})
 
 	t.Run("it builds default with empty string for <nil> value", func(t *testing.T) {
-		got := buildDefaultForString("<nil>")
+		got := buildDefaultForString(Options{}, "<nil>")
 		want := " DEFAULT ''"
 
 		assert.Equal(t, want, got)
 	})
 
 	t.Run("it builds normal default", func(t *testing.T) {
-		got := buildDefaultForString("value")
+		got := buildDefaultForString(Options{}, "value")
 		want := " DEFAULT 'value'"
 
 		assert.Equal(t, want, got)
@@ -0,0 +1,12 @@
+package migrator
+
+// Dialect specifies the SQL flavour statements are built for.
+// Empty dialect is treated as MySQL.
+type Dialect string
+
+const (
+	// MySQL is the default dialect
+	MySQL Dialect = "mysql"
+	// MariaDB dialect
+	MariaDB Dialect = "mariadb"
+)
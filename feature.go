@@ -0,0 +1,64 @@
+package migrator
+
+// Feature represents an engine capability required by a command.
+//
+// - Name		human readable name of the capability
+// - Version	minimal MySQL version supporting the capability
+type Feature struct {
+	Name    string
+	Version string
+}
+
+var (
+	// FeaturePageCompression is a transparent page compression for InnoDB tables.
+	FeaturePageCompression = Feature{Name: "page compression", Version: "5.7.8"}
+
+	// FeatureRenameIndex is `RENAME {INDEX | KEY}` clause within `ALTER TABLE`.
+	FeatureRenameIndex = Feature{Name: "rename index", Version: "5.7.1"}
+
+	// FeatureRenameColumn is `RENAME COLUMN` clause within `ALTER TABLE`.
+	FeatureRenameColumn = Feature{Name: "rename column", Version: "8.0.3"}
+
+	// FeatureDescendingIndex is an index with descending key parts.
+	FeatureDescendingIndex = Feature{Name: "descending index", Version: "8.0.1"}
+
+	// FeatureCheckConstraint is an enforced `CHECK` constraint.
+	FeatureCheckConstraint = Feature{Name: "check constraint", Version: "8.0.16"}
+
+	// FeatureInvisibleIndex is an index not used by the optimizer.
+	FeatureInvisibleIndex = Feature{Name: "invisible index", Version: "8.0.0"}
+
+	// FeatureInvisibleColumn is a column hidden from `SELECT *` queries.
+	FeatureInvisibleColumn = Feature{Name: "invisible column", Version: "8.0.23"}
+
+	// FeatureFunctionalKeyParts is an index on the expression instead of a column.
+	FeatureFunctionalKeyParts = Feature{Name: "functional key parts", Version: "8.0.13"}
+
+	// FeatureMultiValuedIndex is an index on JSON array.
+	FeatureMultiValuedIndex = Feature{Name: "multi-valued index", Version: "8.0.17"}
+)
+
+// FeatureAware is implemented by commands depending on specific engine capabilities.
+type FeatureAware interface {
+	RequiredFeatures() []Feature
+}
+
+// RequiredFeatures returns a list of capabilities the command depends on.
+// Commands that do not implement FeatureAware require no specific capabilities.
+func RequiredFeatures(c Command) []Feature {
+	if f, ok := c.(FeatureAware); ok {
+		return f.RequiredFeatures()
+	}
+
+	return nil
+}
+
+func appendFeature(features []Feature, f Feature) []Feature {
+	for _, item := range features {
+		if item == f {
+			return features
+		}
+	}
+
+	return append(features, f)
+}
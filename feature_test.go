@@ -0,0 +1,71 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredFeatures(t *testing.T) {
+	t.Run("it returns nothing for command without requirements", func(t *testing.T) {
+		assert.Nil(t, RequiredFeatures(DropColumnCommand("test")))
+	})
+
+	t.Run("it returns rename column feature", func(t *testing.T) {
+		assert.Equal(t, []Feature{FeatureRenameColumn}, RequiredFeatures(RenameColumnCommand{Old: "a", New: "b"}))
+	})
+
+	t.Run("it returns rename index feature", func(t *testing.T) {
+		assert.Equal(t, []Feature{FeatureRenameIndex}, RequiredFeatures(RenameIndexCommand{Old: "a", New: "b"}))
+	})
+
+	t.Run("it returns page compression feature", func(t *testing.T) {
+		assert.Equal(t, []Feature{FeaturePageCompression}, RequiredFeatures(SetCompressionCommand("zlib")))
+	})
+
+	t.Run("it returns nothing for plain index", func(t *testing.T) {
+		assert.Nil(t, RequiredFeatures(AddIndexCommand{Name: "idx", Columns: []string{"a"}}))
+	})
+
+	t.Run("it returns functional index features", func(t *testing.T) {
+		c := AddIndexCommand{Name: "idx", Parts: []KeyPart{
+			{Expression: "LOWER(email)"},
+			{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+		}}
+
+		assert.Equal(t, []Feature{FeatureFunctionalKeyParts, FeatureMultiValuedIndex}, RequiredFeatures(c))
+	})
+
+	t.Run("it returns descending index feature for unique index", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "u", Parts: []KeyPart{{Column: "a", Direction: "DESC"}}}
+
+		assert.Equal(t, []Feature{FeatureDescendingIndex}, RequiredFeatures(c))
+	})
+
+	t.Run("it returns invisible index feature", func(t *testing.T) {
+		assert.Equal(t, []Feature{FeatureInvisibleIndex}, RequiredFeatures(AddIndexCommand{Name: "idx", Columns: []string{"a"}, Invisible: true}))
+		assert.Equal(t, []Feature{FeatureInvisibleIndex}, RequiredFeatures(AddUniqueIndexCommand{Key: "u", Columns: []string{"a"}, Invisible: true}))
+	})
+
+	t.Run("it returns invisible column feature", func(t *testing.T) {
+		assert.Nil(t, RequiredFeatures(AddColumnCommand{Name: "a", Column: Integer{}}))
+		assert.Equal(t, []Feature{FeatureInvisibleColumn}, RequiredFeatures(AddColumnCommand{Name: "a", Column: Integer{Invisible: true}}))
+		assert.Equal(t, []Feature{FeatureInvisibleColumn}, RequiredFeatures(ModifyColumnCommand{Name: "a", Column: JSON{Invisible: true}}))
+		assert.Equal(
+			t,
+			[]Feature{FeatureInvisibleColumn},
+			RequiredFeatures(ChangeColumnCommand{From: "a", To: "b", Column: Generated{Type: "int", Expression: "1", Invisible: true}}),
+		)
+	})
+
+	t.Run("it returns unique features for table commands", func(t *testing.T) {
+		c := TableCommands{
+			RenameColumnCommand{Old: "a", New: "b"},
+			DropColumnCommand("c"),
+			RenameColumnCommand{Old: "d", New: "e"},
+			SetCompressionCommand("lz4"),
+		}
+
+		assert.Equal(t, []Feature{FeatureRenameColumn, FeaturePageCompression}, c.RequiredFeatures())
+	})
+}
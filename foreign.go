@@ -7,32 +7,68 @@ import (
 
 type foreigns []Foreign
 
-func (f foreigns) render() string {
+func (f foreigns) render(o Options) string {
 	values := []string{}
 
 	for _, foreign := range f {
-		values = append(values, foreign.render())
+		if value := foreign.render(o); value != "" {
+			values = append(values, value)
+		}
 	}
 
 	return strings.Join(values, ", ")
 }
 
 // Foreign represents an instance to handle foreign key interactions
+//
+// Composite foreign key lists additional columns in Columns and References,
+// they are appended to Column and Reference and should correspond positionally.
+//
+// Example:
+//		migrator.Foreign{Key: "fk", Columns: []string{"a", "b"}, References: []string{"x", "y"}, On: "parent"}
+//			↪️ CONSTRAINT `fk` FOREIGN KEY (`a`, `b`) REFERENCES `parent` (`x`, `y`)
 type Foreign struct {
-	Key       string
-	Column    string
-	Reference string // reference field
-	On        string // reference table
-	OnUpdate  string
-	OnDelete  string
+	Key        string
+	Column     string
+	Reference  string // reference field
+	On         string // reference table
+	OnUpdate   string
+	OnDelete   string
+	Columns    []string
+	References []string
+}
+
+func (f Foreign) columns() []string {
+	return joinNonEmpty(f.Column, f.Columns)
+}
+
+func (f Foreign) references() []string {
+	return joinNonEmpty(f.Reference, f.References)
+}
+
+func joinNonEmpty(first string, rest []string) []string {
+	result := []string{}
+	if first != "" {
+		result = append(result, first)
+	}
+
+	return append(result, rest...)
 }
 
-func (f Foreign) render() string {
-	if f.Key == "" || f.Column == "" || f.On == "" || f.Reference == "" {
+func (f Foreign) render(o Options) string {
+	columns := f.columns()
+	references := f.references()
+	if f.Key == "" || f.On == "" || len(columns) == 0 || len(columns) != len(references) {
 		return ""
 	}
 
-	sql := fmt.Sprintf("CONSTRAINT `%s` FOREIGN KEY (`%s`) REFERENCES `%s` (`%s`)", f.Key, f.Column, f.On, f.Reference)
+	sql := fmt.Sprintf(
+		"CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		o.quoteIdentifier(f.Key),
+		o.quoteIdentifiers(columns),
+		o.quoteIdentifier(f.On),
+		o.quoteIdentifiers(references),
+	)
 	if referenceOptions.has(strings.ToUpper(f.OnDelete)) {
 		sql += " ON DELETE " + strings.ToUpper(f.OnDelete)
 	}
@@ -43,11 +79,40 @@ func (f Foreign) render() string {
 	return sql
 }
 
+func (f Foreign) validate() error {
+	references := f.references()
+	if len(references) == 0 {
+		return fmt.Errorf("foreign key `%s`: %w", f.Key, ErrMissingReferencedColumns)
+	}
+
+	if columns := f.columns(); len(columns) != len(references) {
+		return fmt.Errorf(
+			"foreign key `%s` has %d columns and %d referenced columns: %w",
+			f.Key,
+			len(columns),
+			len(references),
+			ErrForeignColumnsMismatch,
+		)
+	}
+
+	return nil
+}
+
 // BuildForeignNameOnTable builds a name for the foreign key on the table
 func BuildForeignNameOnTable(table string, column string) string {
 	return table + "_" + column + "_foreign"
 }
 
+// BuildImplicitForeignNameOnTable builds a name MySQL generates for unnamed foreign key,
+// ordinal is a 1-based position of the unnamed foreign key on the table.
+//
+// Example:
+//		migrator.DropForeignCommand(migrator.BuildImplicitForeignNameOnTable("comments", 1))
+//			↪️ DROP FOREIGN KEY `comments_ibfk_1`
+func BuildImplicitForeignNameOnTable(table string, ordinal int) string {
+	return fmt.Sprintf("%s_ibfk_%d", table, ordinal)
+}
+
 var referenceOptions = list{"SET NULL", "CASCADE", "RESTRICT", "NO ACTION", "SET DEFAULT"}
 
 type list []string
@@ -61,3 +126,15 @@ func (l list) has(value string) bool {
 
 	return false
 }
+
+func (l list) without(value string) list {
+	result := list{}
+
+	for _, item := range l {
+		if item != value {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
@@ -1,6 +1,7 @@
 package migrator
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,13 +11,22 @@ func TestForeigns(t *testing.T) {
 	t.Run("it returns empty on empty keys", func(t *testing.T) {
 		f := foreigns{Foreign{}}
 
-		assert.Equal(t, "", f.render())
+		assert.Equal(t, "", f.render(Options{}))
 	})
 
 	t.Run("it renders row from one foreign", func(t *testing.T) {
 		f := foreigns{Foreign{Key: "idx_foreign", Column: "test_id", Reference: "id", On: "tests"}}
 
-		assert.Equal(t, "CONSTRAINT `idx_foreign` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render())
+		assert.Equal(t, "CONSTRAINT `idx_foreign` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
+	})
+
+	t.Run("it skips empty foreigns", func(t *testing.T) {
+		f := foreigns{
+			Foreign{Key: "idx_foreign", Column: "test_id", Reference: "id", On: "tests"},
+			Foreign{Key: "broken_foreign", Column: "random_id", Reference: "id"},
+		}
+
+		assert.Equal(t, "CONSTRAINT `idx_foreign` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
 	})
 
 	t.Run("it renders row from multiple foreigns", func(t *testing.T) {
@@ -28,7 +38,7 @@ func TestForeigns(t *testing.T) {
 		assert.Equal(
 			t,
 			"CONSTRAINT `idx_foreign` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`), CONSTRAINT `foreign_idx` FOREIGN KEY (`random_id`) REFERENCES `randoms` (`id`)",
-			f.render(),
+			f.render(Options{}),
 		)
 	})
 }
@@ -37,40 +47,96 @@ func TestForeign(t *testing.T) {
 	t.Run("it builds base constraint", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
 	})
 
 	t.Run("it builds contraint with on_update", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests", OnUpdate: "no action"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON UPDATE NO ACTION", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON UPDATE NO ACTION", f.render(Options{}))
 	})
 
 	t.Run("it builds contraint without invalid on_update", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests", OnUpdate: "null"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
 	})
 
 	t.Run("it builds contraint with on_update", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests", OnDelete: "set default"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON DELETE SET DEFAULT", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON DELETE SET DEFAULT", f.render(Options{}))
 	})
 
 	t.Run("it builds contraint without invalid on_update", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests", OnDelete: "default"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
+	})
+
+	t.Run("it builds composite constraint", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Column: "a", Columns: []string{"b"}, Reference: "x", References: []string{"y"}, On: "tests"}
+
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`a`, `b`) REFERENCES `tests` (`x`, `y`)", f.render(Options{}))
+	})
+
+	t.Run("it builds composite constraint from lists only", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Columns: []string{"b", "a"}, References: []string{"y", "x"}, On: "tests"}
+
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`b`, `a`) REFERENCES `tests` (`y`, `x`)", f.render(Options{}))
+	})
+
+	t.Run("it returns empty on columns length mismatch", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Columns: []string{"a", "b"}, References: []string{"x"}, On: "tests"}
+
+		assert.Equal(t, "", f.render(Options{}))
 	})
 
 	t.Run("it builds full contraint", func(t *testing.T) {
 		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests", OnUpdate: "cascade", OnDelete: "restrict"}
 
-		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON DELETE RESTRICT ON UPDATE CASCADE", f.render())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`) ON DELETE RESTRICT ON UPDATE CASCADE", f.render(Options{}))
+	})
+}
+
+func TestForeignValidate(t *testing.T) {
+	t.Run("it requires referenced columns", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Column: "test_id", On: "tests"}
+		err := f.validate()
+
+		assert.True(t, errors.Is(err, ErrMissingReferencedColumns))
+		assert.Contains(t, err.Error(), "`foreign_idx`")
+		assert.Equal(t, "", f.render(Options{}))
+	})
+
+	t.Run("it detects columns length mismatch", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Columns: []string{"a", "b"}, References: []string{"x"}, On: "tests"}
+		err := f.validate()
+
+		assert.True(t, errors.Is(err, ErrForeignColumnsMismatch))
+		assert.Contains(t, err.Error(), "has 2 columns and 1 referenced columns")
+	})
+
+	t.Run("it passes with composite columns", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Columns: []string{"a", "b"}, References: []string{"x", "y"}, On: "tests"}
+
+		assert.Nil(t, f.validate())
+	})
+
+	t.Run("it passes with referenced columns", func(t *testing.T) {
+		f := Foreign{Key: "foreign_idx", Column: "test_id", Reference: "id", On: "tests"}
+
+		assert.Nil(t, f.validate())
+		assert.Equal(t, "CONSTRAINT `foreign_idx` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", f.render(Options{}))
 	})
 }
 
 func TestBuildForeignIndexNameOnTable(t *testing.T) {
 	assert.Equal(t, "table_test_foreign", BuildForeignNameOnTable("table", "test"))
 }
+
+func TestBuildImplicitForeignNameOnTable(t *testing.T) {
+	assert.Equal(t, "table_ibfk_1", BuildImplicitForeignNameOnTable("table", 1))
+	assert.Equal(t, "table_ibfk_12", BuildImplicitForeignNameOnTable("table", 12))
+	assert.Equal(t, "DROP FOREIGN KEY `table_ibfk_2`", DropForeignCommand(BuildImplicitForeignNameOnTable("table", 2)).ToSQL())
+}
@@ -0,0 +1,25 @@
+package migrator
+
+import "strings"
+
+func (o Options) transformIdentifier(name string) string {
+	if o.IdentifierTransform == nil {
+		return name
+	}
+
+	return o.IdentifierTransform(name)
+}
+
+func (o Options) quoteIdentifier(name string) string {
+	return "`" + o.transformIdentifier(name) + "`"
+}
+
+func (o Options) quoteIdentifiers(names []string) string {
+	values := []string{}
+
+	for _, name := range names {
+		values = append(values, o.quoteIdentifier(name))
+	}
+
+	return strings.Join(values, ", ")
+}
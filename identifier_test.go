@@ -0,0 +1,59 @@
+package migrator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierTransform(t *testing.T) {
+	t.Run("it keeps identifiers by default", func(t *testing.T) {
+		assert.Equal(t, "`UserID`", Options{}.quoteIdentifier("UserID"))
+		assert.Equal(t, "`A`, `b`", Options{}.quoteIdentifiers([]string{"A", "b"}))
+	})
+
+	t.Run("it applies lowercase transform across commands", func(t *testing.T) {
+		c := alterTableCommand{"Users", TableCommands{
+			AddColumnCommand{Name: "TenantID", Column: Integer{Nullable: Null}, After: "ID"},
+			RenameColumnCommand{Old: "FullName", New: "DisplayName"},
+			DropColumnCommand("LegacyField"),
+			AddIndexCommand{Name: "Tenant_IDX", Columns: []string{"TenantID"}},
+			AddForeignCommand{Foreign{Key: "Tenant_FK", Column: "TenantID", Reference: "ID", On: "Tenants"}},
+		}}
+
+		assert.Equal(
+			t,
+			"ALTER TABLE `users` ADD COLUMN `tenantid` int NULL AFTER `id`, "+
+				"RENAME COLUMN `fullname` TO `displayname`, "+
+				"DROP COLUMN `legacyfield`, "+
+				"ADD KEY `tenant_idx` (`tenantid`), "+
+				"ADD CONSTRAINT `tenant_fk` FOREIGN KEY (`tenantid`) REFERENCES `tenants` (`id`)",
+			c.ToSQLWithOptions(Options{IdentifierTransform: strings.ToLower}),
+		)
+	})
+
+	t.Run("it applies transform to created table", func(t *testing.T) {
+		tb := Table{Name: "Posts"}
+		tb.Column("Title", testColumnType("varchar(64)"))
+		tb.Index("Title_IDX", "Title")
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `posts` (`title` varchar(64), KEY `title_idx` (`title`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			createTableCommand{tb}.ToSQLWithOptions(Options{IdentifierTransform: strings.ToLower}),
+		)
+	})
+
+	t.Run("it renders the same command with different options independently", func(t *testing.T) {
+		c := RenameColumnCommand{Old: "FullName", New: "DisplayName"}
+
+		assert.Equal(t, "RENAME COLUMN `fullname` TO `displayname`", ToSQLWithOptions(c, Options{IdentifierTransform: strings.ToLower}))
+		assert.Equal(t, "RENAME COLUMN `FULLNAME` TO `DISPLAYNAME`", ToSQLWithOptions(c, Options{IdentifierTransform: strings.ToUpper}))
+		assert.Equal(t, "RENAME COLUMN `FullName` TO `DisplayName`", c.ToSQL())
+	})
+
+	t.Run("it falls back to plain rendering for commands without options", func(t *testing.T) {
+		assert.Equal(t, "Do action on Test", ToSQLWithOptions(testCommand("Test"), Options{IdentifierTransform: strings.ToLower}))
+	})
+}
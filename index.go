@@ -0,0 +1,84 @@
+package migrator
+
+// IndexBuilder builds the index addition command step by step.
+// Ordering and prefix length are applied to the last added key part.
+//
+// Example:
+//		migrator.Index("idx").Column("a").Desc().Column("b").Prefix(20).Unique().Command()
+//			↪️ ADD UNIQUE KEY `idx` (`a` DESC, `b`(20))
+type IndexBuilder struct {
+	name      string
+	parts     []KeyPart
+	unique    bool
+	invisible bool
+}
+
+// Index starts building the index with the given name.
+func Index(name string) IndexBuilder {
+	return IndexBuilder{name: name}
+}
+
+// Column adds the column key part.
+func (b IndexBuilder) Column(name string) IndexBuilder {
+	return b.withPart(KeyPart{Column: name})
+}
+
+// Expression adds the functional key part.
+func (b IndexBuilder) Expression(expression string) IndexBuilder {
+	return b.withPart(KeyPart{Expression: expression})
+}
+
+// Asc sets ascending order on the last key part.
+func (b IndexBuilder) Asc() IndexBuilder {
+	return b.withLast(func(p *KeyPart) { p.Direction = "asc" })
+}
+
+// Desc sets descending order on the last key part.
+func (b IndexBuilder) Desc() IndexBuilder {
+	return b.withLast(func(p *KeyPart) { p.Direction = "desc" })
+}
+
+// Prefix sets prefix length on the last key part.
+func (b IndexBuilder) Prefix(length uint16) IndexBuilder {
+	return b.withLast(func(p *KeyPart) { p.Length = length })
+}
+
+// Unique makes the index unique.
+func (b IndexBuilder) Unique() IndexBuilder {
+	b.unique = true
+	return b
+}
+
+// Invisible hides the index from the optimizer.
+func (b IndexBuilder) Invisible() IndexBuilder {
+	b.invisible = true
+	return b
+}
+
+// Command returns AddUniqueIndexCommand for unique index, AddIndexCommand otherwise.
+// The result is equal to the command built from the struct, so it could be added to TableCommands.
+func (b IndexBuilder) Command() Command {
+	parts := append([]KeyPart{}, b.parts...)
+
+	if b.unique {
+		return AddUniqueIndexCommand{Key: b.name, Parts: parts, Invisible: b.invisible}
+	}
+
+	return AddIndexCommand{Name: b.name, Parts: parts, Invisible: b.invisible}
+}
+
+func (b IndexBuilder) withPart(p KeyPart) IndexBuilder {
+	b.parts = append(append([]KeyPart{}, b.parts...), p)
+	return b
+}
+
+func (b IndexBuilder) withLast(apply func(p *KeyPart)) IndexBuilder {
+	if len(b.parts) == 0 {
+		return b
+	}
+
+	b.parts = append([]KeyPart{}, b.parts...)
+	apply(&b.parts[len(b.parts)-1])
+
+	return b
+}
@@ -0,0 +1,48 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexBuilder(t *testing.T) {
+	t.Run("it returns an empty string without key parts", func(t *testing.T) {
+		assert.Equal(t, "", Index("idx").Desc().Prefix(10).Command().ToSQL())
+	})
+
+	t.Run("it builds simple index", func(t *testing.T) {
+		b := Index("idx").Column("a").Column("b")
+
+		assert.Equal(t, AddIndexCommand{Name: "idx", Parts: []KeyPart{{Column: "a"}, {Column: "b"}}}, b.Command())
+		assert.Equal(t, "ADD KEY `idx` (`a`, `b`)", b.Command().ToSQL())
+	})
+
+	t.Run("it builds composite unique descending index", func(t *testing.T) {
+		b := Index("idx").Column("a").Desc().Column("b").Prefix(20).Unique()
+		expected := AddUniqueIndexCommand{Key: "idx", Parts: []KeyPart{
+			{Column: "a", Direction: "desc"},
+			{Column: "b", Length: 20},
+		}}
+
+		assert.Equal(t, expected, b.Command())
+		assert.Equal(t, expected.ToSQL(), b.Command().ToSQL())
+		assert.Equal(t, "ADD UNIQUE KEY `idx` (`a` DESC, `b`(20))", b.Command().ToSQL())
+	})
+
+	t.Run("it builds invisible functional index", func(t *testing.T) {
+		b := Index("idx").Expression("lower(email)").Asc().Invisible()
+
+		assert.Equal(t, "ADD KEY `idx` ((lower(email))) INVISIBLE", b.Command().ToSQL())
+	})
+
+	t.Run("it does not share key parts between branches", func(t *testing.T) {
+		base := Index("idx").Column("a")
+		desc := base.Desc()
+		prefixed := base.Column("b").Prefix(5)
+
+		assert.Equal(t, "ADD KEY `idx` (`a`)", base.Command().ToSQL())
+		assert.Equal(t, "ADD KEY `idx` (`a` DESC)", desc.Command().ToSQL())
+		assert.Equal(t, "ADD KEY `idx` (`a`, `b`(5))", prefixed.Command().ToSQL())
+	})
+}
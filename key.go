@@ -1,14 +1,17 @@
 package migrator
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 type keys []Key
 
-func (k keys) render() string {
+func (k keys) render(o Options) string {
 	values := []string{}
 
 	for _, key := range k {
-		value := key.render()
+		value := key.render(o)
 		if value != "" {
 			values = append(values, value)
 		}
@@ -17,16 +20,66 @@ func (k keys) render() string {
 	return strings.Join(values, ", ")
 }
 
+// withImplicit returns a copy of keys with implicit keys appended,
+// unless one of the keys already starts with their columns.
+func (k keys) withImplicit(implicit keys) keys {
+	result := append(keys{}, k...)
+
+	for _, key := range implicit {
+		if !result.covers(key.Columns) {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+// covers checks if any key starts with the given columns, so it can be used for lookups on them
+func (k keys) covers(columns []string) bool {
+	for _, key := range k {
+		if len(key.Columns) < len(columns) {
+			continue
+		}
+
+		covered := true
+		for i, column := range columns {
+			if !strings.EqualFold(key.Columns[i], column) {
+				covered = false
+				break
+			}
+		}
+
+		if covered {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k keys) without(name string) keys {
+	result := keys{}
+
+	for _, key := range k {
+		if !strings.EqualFold(key.Name, name) {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
 // Key represents an instance to handle key (index) interactions
 type Key struct {
-	Name    string
-	Type    string // primary, unique
-	Columns []string
+	Name      string
+	Type      string // primary, unique
+	Columns   []string
+	Invisible bool // not applicable for primary key
 }
 
 var keyTypes = list{"PRIMARY", "UNIQUE"}
 
-func (k Key) render() string {
+func (k Key) render(o Options) string {
 	if len(k.Columns) == 0 {
 		return ""
 	}
@@ -39,14 +92,131 @@ func (k Key) render() string {
 	sql += "KEY"
 
 	if k.Name != "" {
-		sql += " `" + k.Name + "`"
+		sql += " " + o.quoteIdentifier(k.Name)
+	}
+
+	sql += " (" + o.quoteIdentifiers(k.Columns) + ")"
+
+	if k.Invisible && strings.ToUpper(k.Type) != "PRIMARY" {
+		sql += " INVISIBLE"
+	}
+
+	return sql
+}
+
+type keyParts []KeyPart
+
+func (kp keyParts) render(o Options) string {
+	return kp.renderOrdered(o, false)
+}
+
+// renderOrdered renders key parts, ascending order is emitted explicitly if requested
+func (kp keyParts) renderOrdered(o Options, explicitAsc bool) string {
+	values := []string{}
+	multiValued := 0
+
+	for _, part := range kp {
+		value := part.renderOrdered(o, explicitAsc)
+		if value == "" {
+			return ""
+		}
+		if part.MultiValued {
+			multiValued++
+		}
+
+		values = append(values, value)
+	}
+
+	// only one multi-valued key part is allowed per index
+	if multiValued > 1 {
+		return ""
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func (kp keyParts) requiredFeatures() []Feature {
+	var features []Feature
+
+	for _, p := range kp {
+		if p.Expression != "" {
+			features = appendFeature(features, FeatureFunctionalKeyParts)
+		}
+		if p.MultiValued {
+			features = appendFeature(features, FeatureMultiValuedIndex)
+		}
+		if strings.ToUpper(p.Direction) == "DESC" {
+			features = appendFeature(features, FeatureDescendingIndex)
+		}
+	}
+
+	return features
+}
+
+// KeyPart represents a single part of the key (index).
+// It is either a column or a functional expression.
+//
+// Examples:
+//		column		➡️ migrator.KeyPart{Column: "email"}
+//			↪️ `email`
+//		prefix		➡️ migrator.KeyPart{Column: "name", Length: 20, Direction: "desc"}
+//			↪️ `name`(20) DESC
+//		functional	➡️ migrator.KeyPart{Expression: "LOWER(email)"}
+//			↪️ (LOWER(email))
+//		multi-valued	➡️ migrator.KeyPart{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true}
+//			↪️ (CAST(data->'$.tags' AS CHAR(64) ARRAY))
+type KeyPart struct {
+	Column     string
+	Length     uint16 // prefix length, applicable for columns only
+	Direction  string // asc, desc
+	Expression string // rendered verbatim, wrapped with parentheses unless already wrapped
+
+	// MultiValued marks the expression as a multi-valued (JSON array) key part.
+	// Info ℹ️ available since MySQL 8.0.17
+	MultiValued bool
+}
+
+func (p KeyPart) render(o Options) string {
+	return p.renderOrdered(o, false)
+}
+
+func (p KeyPart) renderOrdered(o Options, explicitAsc bool) string {
+	sql := ""
+
+	if p.Expression != "" {
+		sql = p.Expression
+		if !isWrappedInParentheses(sql) {
+			sql = "(" + sql + ")"
+		}
+	} else if p.MultiValued || p.Column == "" {
+		return ""
+	} else {
+		sql = o.quoteIdentifier(p.Column)
+
+		if p.Length > 0 {
+			sql += "(" + strconv.Itoa(int(p.Length)) + ")"
+		}
 	}
 
-	sql += " (`" + strings.Join(k.Columns, "`, `") + "`)"
+	if strings.ToUpper(p.Direction) == "DESC" {
+		sql += " DESC"
+	} else if explicitAsc && !p.MultiValued {
+		sql += " ASC"
+	}
 
 	return sql
 }
 
+func columnsToKeyParts(columns []string) keyParts {
+	parts := keyParts{}
+
+	for _, c := range columns {
+		parts = append(parts, KeyPart{Column: c})
+	}
+
+	return parts
+}
+
 // BuildUniqueKeyNameOnTable builds a name for the foreign key on the table
 func BuildUniqueKeyNameOnTable(table string, columns ...string) string {
 	return table + "_" + strings.Join(columns, "_") + "_unique"
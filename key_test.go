@@ -10,13 +10,13 @@ func TestKeys(t *testing.T) {
 	t.Run("it returns empty on empty keys", func(t *testing.T) {
 		k := keys{Key{}}
 
-		assert.Equal(t, "", k.render())
+		assert.Equal(t, "", k.render(Options{}))
 	})
 
 	t.Run("it renders row from one key", func(t *testing.T) {
 		k := keys{Key{Columns: []string{"test_id"}}}
 
-		assert.Equal(t, "KEY (`test_id`)", k.render())
+		assert.Equal(t, "KEY (`test_id`)", k.render(Options{}))
 	})
 
 	t.Run("it renders row from multiple keys", func(t *testing.T) {
@@ -28,40 +28,74 @@ func TestKeys(t *testing.T) {
 		assert.Equal(
 			t,
 			"KEY (`test_id`), KEY (`random_id`)",
-			k.render(),
+			k.render(Options{}),
 		)
 	})
+
+	t.Run("it checks if columns are covered by key prefix", func(t *testing.T) {
+		k := keys{Key{Columns: []string{"tenant_id", "user_id"}}}
+
+		assert.True(t, k.covers([]string{"tenant_id"}))
+		assert.True(t, k.covers([]string{"Tenant_ID", "user_id"}))
+		assert.False(t, k.covers([]string{"user_id"}))
+		assert.False(t, k.covers([]string{"tenant_id", "user_id", "id"}))
+		assert.False(t, keys{}.covers([]string{"tenant_id"}))
+	})
+
+	t.Run("it appends implicit keys not covered by declared keys", func(t *testing.T) {
+		k := keys{Key{Name: "tenant_user_idx", Columns: []string{"tenant_id", "user_id"}}}
+		implicit := keys{
+			Key{Name: "tenant_fk", Columns: []string{"tenant_id"}},
+			Key{Name: "user_fk", Columns: []string{"user_id"}},
+			Key{Name: "user_fk_copy", Columns: []string{"user_id"}},
+		}
+
+		assert.Equal(t, keys{k[0], implicit[1]}, k.withImplicit(implicit))
+		assert.Len(t, k, 1)
+	})
 }
 
 func TestKey(t *testing.T) {
 	t.Run("it returns empty on empty keys", func(t *testing.T) {
 		k := Key{}
 
-		assert.Equal(t, "", k.render())
+		assert.Equal(t, "", k.render(Options{}))
 	})
 
 	t.Run("it skips type if it is not in valid list", func(t *testing.T) {
 		k := Key{Type: "random", Columns: []string{"test_id"}}
 
-		assert.Equal(t, "KEY (`test_id`)", k.render())
+		assert.Equal(t, "KEY (`test_id`)", k.render(Options{}))
 	})
 
 	t.Run("it renders with type", func(t *testing.T) {
 		k := Key{Type: "primary", Columns: []string{"test_id"}}
 
-		assert.Equal(t, "PRIMARY KEY (`test_id`)", k.render())
+		assert.Equal(t, "PRIMARY KEY (`test_id`)", k.render(Options{}))
 	})
 
 	t.Run("it renders with multiple columns", func(t *testing.T) {
 		k := Key{Type: "unique", Columns: []string{"test_id", "random_id"}}
 
-		assert.Equal(t, "UNIQUE KEY (`test_id`, `random_id`)", k.render())
+		assert.Equal(t, "UNIQUE KEY (`test_id`, `random_id`)", k.render(Options{}))
 	})
 
 	t.Run("it renders with name", func(t *testing.T) {
 		k := Key{Name: "random_idx", Columns: []string{"test_id"}}
 
-		assert.Equal(t, "KEY `random_idx` (`test_id`)", k.render())
+		assert.Equal(t, "KEY `random_idx` (`test_id`)", k.render(Options{}))
+	})
+
+	t.Run("it renders invisible key", func(t *testing.T) {
+		k := Key{Name: "random_idx", Type: "unique", Columns: []string{"test_id"}, Invisible: true}
+
+		assert.Equal(t, "UNIQUE KEY `random_idx` (`test_id`) INVISIBLE", k.render(Options{}))
+	})
+
+	t.Run("it does not render invisible primary key", func(t *testing.T) {
+		k := Key{Type: "primary", Columns: []string{"id"}, Invisible: true}
+
+		assert.Equal(t, "PRIMARY KEY (`id`)", k.render(Options{}))
 	})
 }
 
@@ -74,3 +108,70 @@ func TestBuildUniqueIndexName(t *testing.T) {
 		assert.Equal(t, "table_test_again_unique", BuildUniqueKeyNameOnTable("table", "test", "again"))
 	})
 }
+
+func TestKeyParts(t *testing.T) {
+	t.Run("it renders columns", func(t *testing.T) {
+		kp := keyParts{KeyPart{Column: "a"}, KeyPart{Column: "b"}}
+
+		assert.Equal(t, "`a`, `b`", kp.render(Options{}))
+	})
+
+	t.Run("it renders prefix length and direction", func(t *testing.T) {
+		kp := keyParts{KeyPart{Column: "a", Length: 20, Direction: "desc"}, KeyPart{Column: "b", Direction: "asc"}}
+
+		assert.Equal(t, "`a`(20) DESC, `b`", kp.render(Options{}))
+	})
+
+	t.Run("it ignores invalid direction", func(t *testing.T) {
+		kp := keyParts{KeyPart{Column: "a", Direction: "random"}}
+
+		assert.Equal(t, "`a`", kp.render(Options{}))
+	})
+
+	t.Run("it renders functional key part", func(t *testing.T) {
+		kp := keyParts{KeyPart{Expression: "LOWER(email)"}}
+
+		assert.Equal(t, "(LOWER(email))", kp.render(Options{}))
+	})
+
+	t.Run("it renders explicit ascending order except multi-valued parts", func(t *testing.T) {
+		kp := keyParts{
+			KeyPart{Column: "a"},
+			KeyPart{Column: "b", Direction: "desc"},
+			KeyPart{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+		}
+
+		assert.Equal(t, "`a` ASC, `b` DESC, (CAST(data->'$.tags' AS CHAR(64) ARRAY))", kp.renderOrdered(Options{}, true))
+		assert.Equal(t, "`a`, `b` DESC, (CAST(data->'$.tags' AS CHAR(64) ARRAY))", kp.render(Options{}))
+	})
+
+	t.Run("it does not wrap parenthesized expression twice", func(t *testing.T) {
+		kp := keyParts{KeyPart{Expression: "(LOWER(email))"}, KeyPart{Expression: "(a) + (b)"}}
+
+		assert.Equal(t, "(LOWER(email)), ((a) + (b))", kp.render(Options{}))
+	})
+
+	t.Run("it renders multi-valued key part", func(t *testing.T) {
+		kp := keyParts{
+			KeyPart{Column: "user_id"},
+			KeyPart{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+		}
+
+		assert.Equal(t, "`user_id`, (CAST(data->'$.tags' AS CHAR(64) ARRAY))", kp.render(Options{}))
+	})
+
+	t.Run("it returns empty on multi-valued key part without expression", func(t *testing.T) {
+		kp := keyParts{KeyPart{Column: "tags", MultiValued: true}}
+
+		assert.Equal(t, "", kp.render(Options{}))
+	})
+
+	t.Run("it returns empty on multiple multi-valued key parts", func(t *testing.T) {
+		kp := keyParts{
+			KeyPart{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+			KeyPart{Expression: "CAST(data->'$.ids' AS UNSIGNED ARRAY)", MultiValued: true},
+		}
+
+		assert.Equal(t, "", kp.render(Options{}))
+	})
+}
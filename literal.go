@@ -0,0 +1,38 @@
+package migrator
+
+import "strings"
+
+func (o Options) booleanLiteral(v bool) string {
+	switch {
+	case o.BooleanLiterals && v:
+		return "TRUE"
+	case o.BooleanLiterals:
+		return "FALSE"
+	case v:
+		return "1"
+	}
+
+	return "0"
+}
+
+func (o Options) escapeString(v string) string {
+	if !o.NoBackslashEscapes {
+		v = strings.ReplaceAll(v, `\`, `\\`)
+	}
+
+	return strings.ReplaceAll(v, "'", "''")
+}
+
+func (o Options) quoteString(v string) string {
+	return "'" + o.escapeString(v) + "'"
+}
+
+func (o Options) quoteStrings(values []string) string {
+	quoted := []string{}
+
+	for _, v := range values {
+		quoted = append(quoted, o.quoteString(v))
+	}
+
+	return strings.Join(quoted, ", ")
+}
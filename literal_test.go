@@ -0,0 +1,48 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringLiteralEscaping(t *testing.T) {
+	t.Run("it escapes quotes and backslashes by default", func(t *testing.T) {
+		assert.Equal(t, `'it''s'`, Options{}.quoteString("it's"))
+		assert.Equal(t, `'C:\\temp\\'' OR 1'`, Options{}.quoteString(`C:\temp\' OR 1`))
+		assert.Equal(t, `'a\\b', 'c''d'`, Options{}.quoteStrings([]string{`a\b`, "c'd"}))
+	})
+
+	t.Run("it escapes only quotes with no backslash escapes mode", func(t *testing.T) {
+		o := Options{NoBackslashEscapes: true}
+
+		assert.Equal(t, `'it''s'`, o.quoteString("it's"))
+		assert.Equal(t, `'C:\temp\'' OR 1'`, o.quoteString(`C:\temp\' OR 1`))
+	})
+
+	t.Run("it escapes comments with backslashes", func(t *testing.T) {
+		c := String{Comment: `path\to\file`, Default: `\n`}
+
+		assert.Equal(t, `varchar COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '\\n' COMMENT 'path\\to\\file'`, c.BuildRow())
+		assert.Equal(t, `COMMENT='it''s C:\\'`, SetCommentCommand(`it's C:\`).ToSQL())
+	})
+
+	t.Run("it keeps backslashes in comments with no backslash escapes mode", func(t *testing.T) {
+		o := Options{NoBackslashEscapes: true}
+		c := String{Comment: `path\to\file`, Default: `\n`}
+
+		assert.Equal(t, `varchar COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '\n' COMMENT 'path\to\file'`, c.BuildRowWithOptions(o))
+		assert.Equal(t, `COMMENT='it''s C:\'`, SetCommentCommand(`it's C:\`).ToSQLWithOptions(o))
+		assert.Equal(
+			t,
+			"ALTER TABLE `t` ADD COLUMN `c` varchar COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '\\n' COMMENT 'path\\to\\file'",
+			alterTableCommand{"t", TableCommands{AddColumnCommand{Name: "c", Column: c}}}.ToSQLWithOptions(o),
+		)
+	})
+
+	t.Run("it escapes enum values", func(t *testing.T) {
+		c := Enum{Values: []string{"on", `o'ff\`}}
+
+		assert.Equal(t, `enum('on', 'o''ff\\') NOT NULL`, c.BuildRow())
+	})
+}
@@ -1,6 +1,9 @@
 package migrator
 
-import "database/sql"
+import (
+	"database/sql"
+	"fmt"
+)
 
 type executableSQL interface {
 	Exec(query string, args ...interface{}) (sql.Result, error)
@@ -10,7 +13,8 @@ type executableSQL interface {
 //
 // Name 		should be a unique name to specify migration. It is up to you to choose the name you like
 // Up() 		should return Schema with prepared commands to be migrated
-// Down()		should return Schema with prepared commands to be reverted
+// Down()		should return Schema with prepared commands to be reverted,
+// 				if it is missing, commands from Up() are reversed automatically
 // Transaction	optinal flag to enable transaction for migration
 //
 // Example:
@@ -44,21 +48,59 @@ type Migration struct {
 	Transaction bool
 }
 
-func (m Migration) exec(db *sql.DB, logger Logger, commands ...Command) error {
+// Validate checks that the migration can be reverted:
+// either Down is provided, or every command from Up can be reversed automatically.
+func (m Migration) Validate() error {
+	if m.Down != nil {
+		return nil
+	}
+
+	_, err := m.down()
+
+	return err
+}
+
+func (m Migration) down() (Schema, error) {
+	if m.Down != nil {
+		return m.Down(), nil
+	}
+
+	var s Schema
+	if m.Up == nil {
+		return s, nil
+	}
+
+	up := m.Up()
+	for i := len(up.pool) - 1; i >= 0; i-- {
+		r := Reverse(up.pool[i])
+		if r == nil {
+			return Schema{}, fmt.Errorf("Migration %q, command %q: %w", m.Name, up.pool[i].ToSQL(), ErrIrreversibleCommand)
+		}
+		if _, ok := r.(noopCommand); ok {
+			continue
+		}
+
+		s.pool = append(s.pool, r)
+	}
+
+	return s, nil
+}
+
+func (m Migration) exec(db *sql.DB, logger Logger, o Options, commands ...Command) error {
 	if m.Transaction {
-		return runInTransaction(db, logger, commands...)
+		return runInTransaction(db, logger, o, commands...)
 	}
 
-	return run(db, logger, commands...)
+	return run(db, logger, o, commands...)
 }
 
-func runInTransaction(db *sql.DB, logger Logger, commands ...Command) error {
+func runInTransaction(db *sql.DB, logger Logger, o Options, commands ...Command) error {
 	tx, err := db.Begin()
 	if err != nil {
 		return err
 	}
 
-	err = run(tx, logger, commands...)
+	err = run(tx, logger, o, commands...)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -72,9 +114,9 @@ func runInTransaction(db *sql.DB, logger Logger, commands ...Command) error {
 	return nil
 }
 
-func run(db executableSQL, logger Logger, commands ...Command) error {
+func run(db executableSQL, logger Logger, o Options, commands ...Command) error {
 	for _, command := range commands {
-		sql := command.ToSQL()
+		sql := ToSQLWithOptions(command, o)
 		if sql == "" {
 			return ErrNoSQLCommandsToRun
 		}
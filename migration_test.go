@@ -3,6 +3,7 @@ package migrator
 import (
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -59,7 +60,7 @@ func TestMigrationExec(t *testing.T) {
 		mock.ExpectCommit()
 
 		// now we execute our method
-		if err := m.exec(db, nil, commands...); err != nil {
+		if err := m.exec(db, nil, Options{}, commands...); err != nil {
 			t.Errorf("error was not expected while running query: %s", err)
 		}
 	})
@@ -77,7 +78,7 @@ func TestMigrationExec(t *testing.T) {
 		mock.ExpectExec(commands[1].ToSQL()).WillReturnResult(sqlmock.NewResult(2, 1))
 
 		// now we execute our method
-		if err := m.exec(db, nil, commands...); err != nil {
+		if err := m.exec(db, nil, Options{}, commands...); err != nil {
 			t.Errorf("error was not expected while running query: %s", err)
 		}
 	})
@@ -93,7 +94,7 @@ func TestRunInTransaction(t *testing.T) {
 		mock.ExpectBegin().WillReturnError(want)
 
 		// now we execute our method
-		got := runInTransaction(db, nil, commands...)
+		got := runInTransaction(db, nil, Options{}, commands...)
 		assert.Equal(t, want, got)
 	})
 
@@ -109,7 +110,7 @@ func TestRunInTransaction(t *testing.T) {
 		mock.ExpectRollback()
 
 		// now we execute our method
-		got := runInTransaction(db, nil, commands...)
+		got := runInTransaction(db, nil, Options{}, commands...)
 		assert.Equal(t, want, got)
 	})
 
@@ -124,7 +125,7 @@ func TestRunInTransaction(t *testing.T) {
 		mock.ExpectCommit().WillReturnError(want)
 
 		// now we execute our method
-		got := runInTransaction(db, nil, commands...)
+		got := runInTransaction(db, nil, Options{}, commands...)
 		assert.Equal(t, want, got)
 	})
 
@@ -143,7 +144,7 @@ func TestRunInTransaction(t *testing.T) {
 		mock.ExpectCommit()
 
 		// now we execute our method
-		if err := runInTransaction(db, nil, commands...); err != nil {
+		if err := runInTransaction(db, nil, Options{}, commands...); err != nil {
 			t.Errorf("error was not expected while running query: %s", err)
 		}
 	})
@@ -161,7 +162,7 @@ func TestRun(t *testing.T) {
 
 		mock.ExpectExec(commands[0].ToSQL()).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := run(db, nil, commands...)
+		err := run(db, nil, Options{}, commands...)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrNoSQLCommandsToRun, err)
@@ -179,7 +180,7 @@ func TestRun(t *testing.T) {
 		mock.ExpectExec(commands[0].ToSQL()).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(commands[1].ToSQL()).WillReturnError(errTestDBExecFailed)
 
-		err := run(db, nil, commands...)
+		err := run(db, nil, Options{}, commands...)
 
 		assert.Error(t, err)
 		assert.Equal(t, errTestDBExecFailed, err)
@@ -197,8 +198,87 @@ func TestRun(t *testing.T) {
 		mock.ExpectExec(commands[0].ToSQL()).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(commands[1].ToSQL()).WillReturnResult(sqlmock.NewResult(2, 1))
 
-		err := run(db, nil, commands...)
+		err := run(db, nil, Options{}, commands...)
 
 		assert.Nil(t, err)
 	})
+
+	t.Run("it returns an error on partially invalid alter table", func(t *testing.T) {
+		db, _, resetDB := testDBConnection(t)
+		defer resetDB()
+
+		c := alterTableCommand{"users", TableCommands{DropColumnCommand("b"), RenameIndexCommand{Old: "PRIMARY", New: "pk"}}}
+
+		assert.Equal(t, ErrNoSQLCommandsToRun, run(db, nil, Options{}, c))
+	})
+
+	t.Run("it renders commands with options", func(t *testing.T) {
+		db, mock, resetDB := testDBConnection(t)
+		defer resetDB()
+
+		mock.ExpectExec("DROP COLUMN `legacy`").WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := run(db, nil, Options{IdentifierTransform: strings.ToLower}, DropColumnCommand("Legacy"))
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestMigrationValidate(t *testing.T) {
+	t.Run("it passes with explicit down", func(t *testing.T) {
+		m := Migration{
+			Name: "test",
+			Up: func() Schema {
+				var s Schema
+				s.AlterTable("test", TableCommands{DropColumnCommand("a")})
+				return s
+			},
+			Down: func() Schema {
+				var s Schema
+				s.AlterTable("test", TableCommands{AddColumnCommand{Name: "a", Column: Integer{}}})
+				return s
+			},
+		}
+
+		assert.Nil(t, m.Validate())
+	})
+
+	t.Run("it passes fully reversible migration", func(t *testing.T) {
+		m := Migration{
+			Name: "test",
+			Up: func() Schema {
+				var s Schema
+				s.CreateTable(Table{Name: "posts"})
+				s.AlterTable("comments", TableCommands{
+					AddColumnCommand{Name: "post_id", Column: Integer{}},
+					AddIndexCommand{Name: "post_idx", Columns: []string{"post_id"}},
+				})
+				return s
+			},
+		}
+
+		assert.Nil(t, m.Validate())
+
+		down, err := m.down()
+		assert.Nil(t, err)
+		assert.Equal(t, []Command{
+			alterTableCommand{"comments", TableCommands{DropIndexCommand("post_idx"), DropColumnCommand("post_id")}},
+			dropTableCommand{table: "posts", soft: true},
+		}, down.pool)
+	})
+
+	t.Run("it fails when irreversible command misses down", func(t *testing.T) {
+		m := Migration{
+			Name: "test",
+			Up: func() Schema {
+				var s Schema
+				s.AlterTable("comments", TableCommands{DropColumnCommand("legacy")})
+				return s
+			},
+		}
+		err := m.Validate()
+
+		assert.True(t, errors.Is(err, ErrIrreversibleCommand))
+		assert.Contains(t, err.Error(), "DROP COLUMN `legacy`")
+	})
 }
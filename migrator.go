@@ -29,6 +29,9 @@ var (
 
 	// ErrNoSQLCommandsToRun returns when migration is invalid and has no commands in the pool
 	ErrNoSQLCommandsToRun = errors.New("There are no commands to be executed")
+
+	// ErrIrreversibleCommand returns when migration without Down has a command that cannot be reversed
+	ErrIrreversibleCommand = errors.New("Command cannot be reversed automatically, Down should be provided")
 )
 
 type Logger func(format string, args ...interface{}) //use fmt.Sprintf
@@ -52,6 +55,8 @@ type Migrator struct {
 	executed []migrationEntry
 
 	Logger Logger
+	// Options control how statements are rendered
+	Options Options
 }
 
 // Migrate runs all migrations from pool and stores in migration table executed migration.
@@ -84,7 +89,7 @@ func (m Migrator) Migrate(db *sql.DB) (migrated []string, err error) {
 		if len(s.pool) == 0 {
 			return migrated, ErrNoSQLCommandsToRun
 		}
-		if err := item.exec(db, m.Logger, s.pool...); err != nil {
+		if err := item.exec(db, m.Logger, m.Options, s.pool...); err != nil {
 			return migrated, err
 		}
 
@@ -133,11 +138,14 @@ func (m Migrator) Rollback(db *sql.DB) (reverted []string, err error) {
 			item := m.Pool[j]
 
 			if item.Name == name {
-				s := item.Down()
+				s, err := item.down()
+				if err != nil {
+					return reverted, err
+				}
 				if len(s.pool) == 0 {
 					return reverted, ErrNoSQLCommandsToRun
 				}
-				if err := item.exec(db, m.Logger, s.pool...); err != nil {
+				if err := item.exec(db, m.Logger, m.Options, s.pool...); err != nil {
 					return reverted, err
 				}
 
@@ -184,11 +192,14 @@ func (m Migrator) Revert(db *sql.DB) (reverted []string, err error) {
 			item := m.Pool[j]
 
 			if item.Name == name {
-				s := item.Down()
+				s, err := item.down()
+				if err != nil {
+					return reverted, err
+				}
 				if len(s.pool) == 0 {
 					return reverted, ErrNoSQLCommandsToRun
 				}
-				if err := item.exec(db, m.Logger, s.pool...); err != nil {
+				if err := item.exec(db, m.Logger, m.Options, s.pool...); err != nil {
 					return reverted, err
 				}
 
@@ -1,6 +1,7 @@
 package migrator
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -313,6 +314,81 @@ func TestRollback(t *testing.T) {
 		assert.Equal(t, ErrNoSQLCommandsToRun, err)
 	})
 
+	t.Run("it fails reverting irreversible migration without down", func(t *testing.T) {
+		migration := Migration{Name: "test", Up: func() Schema {
+			var s Schema
+			s.DropTable("test", false, "")
+			return s
+		}}
+		m := Migrator{Pool: []Migration{migration}}
+		db, mock, resetDB := testDBConnection(t)
+		defer resetDB()
+
+		rows := sqlmock.NewRows([]string{"id", "name", "batch", "applied_at"}).AddRow(1, "test", 1, time.Now())
+
+		mock.ExpectQuery("SELECT").WillReturnRows()
+		mock.ExpectQuery("SELECT id, name, batch, applied_at FROM migrations").WillReturnRows(rows)
+
+		reverted, err := m.Rollback(db)
+
+		assert.Len(t, reverted, 0)
+		assert.True(t, errors.Is(err, ErrIrreversibleCommand))
+	})
+
+	t.Run("it rolls back migration with reversed up commands", func(t *testing.T) {
+		migration := Migration{Name: "test", Up: func() Schema {
+			var s Schema
+			s.CreateTable(Table{Name: "test"})
+			return s
+		}}
+		m := Migrator{Pool: []Migration{migration}}
+		db, mock, resetDB := testDBConnection(t)
+		defer resetDB()
+
+		rows := sqlmock.NewRows([]string{"id", "name", "batch", "applied_at"}).AddRow(1, "test", 1, time.Now())
+
+		mock.ExpectQuery("SELECT").WillReturnRows()
+		mock.ExpectQuery("SELECT id, name, batch, applied_at FROM migrations").WillReturnRows(rows)
+		mock.ExpectExec("DROP TABLE IF EXISTS `test`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM migrations WHERE id = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		reverted, err := m.Rollback(db)
+
+		assert.Equal(t, []string{"test"}, reverted)
+		assert.Nil(t, err)
+	})
+
+	t.Run("it rolls back backfilled column", func(t *testing.T) {
+		migration := Migration{Name: "test", Up: func() Schema {
+			var s Schema
+			s.BackfillColumn(ColumnBackfill{
+				Table:      "users",
+				Name:       "active",
+				Column:     Integer{Prefix: "tiny", Nullable: Null},
+				Expression: "1",
+				NotNull:    Integer{Prefix: "tiny", Default: "1"},
+			})
+			return s
+		}}
+		m := Migrator{Pool: []Migration{migration}}
+		db, mock, resetDB := testDBConnection(t)
+		defer resetDB()
+
+		rows := sqlmock.NewRows([]string{"id", "name", "batch", "applied_at"}).AddRow(1, "test", 1, time.Now())
+
+		mock.ExpectQuery("SELECT").WillReturnRows()
+		mock.ExpectQuery("SELECT id, name, batch, applied_at FROM migrations").WillReturnRows(rows)
+		mock.ExpectExec("ALTER TABLE `users` MODIFY `active` tinyint NULL").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("ALTER TABLE `users` DROP COLUMN `active`").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("DELETE FROM migrations WHERE id = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		reverted, err := m.Rollback(db)
+
+		assert.Equal(t, []string{"test"}, reverted)
+		assert.Nil(t, err)
+		assert.Nil(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("it fails while removing executed migration info", func(t *testing.T) {
 		migration := Migration{Name: "test", Down: func() Schema {
 			var s Schema
@@ -0,0 +1,23 @@
+package migrator
+
+// Options control how statements are rendered.
+// Zero value renders identifiers as is, escapes backslashes in string literals and targets MySQL.
+//
+// Example:
+//		migrator.Migrator{Options: migrator.Options{IdentifierTransform: strings.ToLower}}
+type Options struct {
+	// IdentifierTransform is applied to every identifier (table, column, key, constraint and partition names)
+	// before it is quoted. Identifiers are used as is, if it is not set.
+	IdentifierTransform func(string) string
+
+	// NoBackslashEscapes should be set when the server runs with NO_BACKSLASH_ESCAPES sql mode.
+	// By default backslashes in string literals (comments, defaults, enum values) are escaped
+	// along with single quotes, otherwise only single quotes are doubled.
+	NoBackslashEscapes bool
+
+	// BooleanLiterals renders boolean default values as TRUE/FALSE instead of MySQL canonical 1/0.
+	BooleanLiterals bool
+
+	// Dialect is used for column types which do not specify their own dialect.
+	Dialect Dialect
+}
@@ -0,0 +1,54 @@
+package migrator
+
+import "strings"
+
+type partitions []Partition
+
+func (p partitions) render(o Options) string {
+	values := []string{}
+
+	for _, partition := range p {
+		value := partition.render(o)
+		if value == "" {
+			return ""
+		}
+
+		values = append(values, value)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// Partition represents a partition definition.
+// Range partitions use LessThan, list partitions use In, hash and key partitions only need a name.
+//
+// Examples:
+//		range	➡️ migrator.Partition{Name: "p0", LessThan: "1000"}
+//			↪️ PARTITION `p0` VALUES LESS THAN (1000)
+//		range	➡️ migrator.Partition{Name: "p1", LessThan: "maxvalue"}
+//			↪️ PARTITION `p1` VALUES LESS THAN MAXVALUE
+//		list	➡️ migrator.Partition{Name: "p2", In: []string{"1", "2"}}
+//			↪️ PARTITION `p2` VALUES IN (1, 2)
+type Partition struct {
+	Name     string
+	LessThan string
+	In       []string
+}
+
+func (p Partition) render(o Options) string {
+	if p.Name == "" {
+		return ""
+	}
+
+	sql := "PARTITION " + o.quoteIdentifier(p.Name)
+
+	if strings.ToUpper(p.LessThan) == "MAXVALUE" {
+		sql += " VALUES LESS THAN MAXVALUE"
+	} else if p.LessThan != "" {
+		sql += " VALUES LESS THAN (" + p.LessThan + ")"
+	} else if len(p.In) > 0 {
+		sql += " VALUES IN (" + strings.Join(p.In, ", ") + ")"
+	}
+
+	return sql
+}
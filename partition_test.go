@@ -0,0 +1,53 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitions(t *testing.T) {
+	t.Run("it returns empty on invalid partition", func(t *testing.T) {
+		p := partitions{Partition{Name: "p0"}, Partition{}}
+
+		assert.Equal(t, "", p.render(Options{}))
+	})
+
+	t.Run("it renders multiple partitions", func(t *testing.T) {
+		p := partitions{Partition{Name: "p0"}, Partition{Name: "p1"}}
+
+		assert.Equal(t, "PARTITION `p0`, PARTITION `p1`", p.render(Options{}))
+	})
+}
+
+func TestPartition(t *testing.T) {
+	t.Run("it returns empty on missing name", func(t *testing.T) {
+		p := Partition{LessThan: "100"}
+
+		assert.Equal(t, "", p.render(Options{}))
+	})
+
+	t.Run("it renders hash partition", func(t *testing.T) {
+		p := Partition{Name: "p0"}
+
+		assert.Equal(t, "PARTITION `p0`", p.render(Options{}))
+	})
+
+	t.Run("it renders range partition", func(t *testing.T) {
+		p := Partition{Name: "p0", LessThan: "100"}
+
+		assert.Equal(t, "PARTITION `p0` VALUES LESS THAN (100)", p.render(Options{}))
+	})
+
+	t.Run("it renders range partition with maxvalue", func(t *testing.T) {
+		p := Partition{Name: "p0", LessThan: "maxvalue"}
+
+		assert.Equal(t, "PARTITION `p0` VALUES LESS THAN MAXVALUE", p.render(Options{}))
+	})
+
+	t.Run("it renders list partition", func(t *testing.T) {
+		p := Partition{Name: "p0", In: []string{"1", "2"}}
+
+		assert.Equal(t, "PARTITION `p0` VALUES IN (1, 2)", p.render(Options{}))
+	})
+}
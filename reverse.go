@@ -0,0 +1,41 @@
+package migrator
+
+// Reversible is implemented by commands that can be reverted automatically.
+// Reverse returns nil if the command cannot be reverted.
+type Reversible interface {
+	Reverse() Command
+}
+
+// Reverse returns a command reverting the given one, or nil if it is not reversible.
+func Reverse(c Command) Command {
+	if r, ok := c.(Reversible); ok {
+		return r.Reverse()
+	}
+
+	return nil
+}
+
+// noopCommand is returned as reversal of commands which do not need any statement to be reverted,
+// it is skipped while building the reverted schema.
+type noopCommand struct{}
+
+func (c noopCommand) ToSQL() string {
+	return ""
+}
+
+// Reverse returns commands reverting the pool in the reversed order,
+// or nil if any of commands is not reversible.
+func (tc TableCommands) Reverse() TableCommands {
+	result := TableCommands{}
+
+	for i := len(tc) - 1; i >= 0; i-- {
+		r := Reverse(tc[i])
+		if r == nil {
+			return nil
+		}
+
+		result = append(result, r)
+	}
+
+	return result
+}
@@ -0,0 +1,58 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	t.Run("it returns nil for irreversible commands", func(t *testing.T) {
+		assert.Nil(t, Reverse(DropColumnCommand("test")))
+		assert.Nil(t, Reverse(ModifyColumnCommand{Name: "test", Column: Integer{}}))
+		assert.Nil(t, Reverse(dropTableCommand{table: "test"}))
+		assert.Nil(t, Reverse(AddColumnCommand{}))
+		assert.Nil(t, Reverse(AddCheckCommand{Expression: "a > 0"}))
+	})
+
+	t.Run("it reverses table commands", func(t *testing.T) {
+		assert.Equal(t, DropColumnCommand("test"), Reverse(AddColumnCommand{Name: "test", Column: Integer{}}))
+		assert.Equal(t, RenameColumnCommand{Old: "b", New: "a"}, Reverse(RenameColumnCommand{Old: "a", New: "b"}))
+		assert.Equal(t, DropIndexCommand("idx"), Reverse(AddIndexCommand{Name: "idx", Columns: []string{"a"}}))
+		assert.Equal(t, DropIndexCommand("u"), Reverse(AddUniqueIndexCommand{Key: "u", Columns: []string{"a"}}))
+		assert.Equal(t, RenameIndexCommand{Old: "b", New: "a", Key: true}, Reverse(RenameIndexCommand{Old: "a", New: "b", Key: true}))
+		assert.Equal(t, DropForeignCommand("fk"), Reverse(AddForeignCommand{Foreign{Key: "fk"}}))
+		assert.Equal(t, DropPrimaryIndexCommand{}, Reverse(AddPrimaryIndexCommand("id")))
+		assert.Equal(t, DropCheckCommand("chk"), Reverse(AddCheckCommand{Name: "chk", Expression: "a > 0"}))
+		assert.Equal(
+			t,
+			ModifyColumnCommand{Name: "a", Column: Integer{Nullable: Null}, Old: Integer{}},
+			Reverse(ModifyColumnCommand{Name: "a", Column: Integer{}, Old: Integer{Nullable: Null}}),
+		)
+	})
+
+	t.Run("it reverses schema commands", func(t *testing.T) {
+		assert.Equal(t, dropTableCommand{table: "test", soft: true}, Reverse(createTableCommand{Table{Name: "test"}}))
+		assert.Equal(t, renameTableCommand{old: "b", new: "a"}, Reverse(renameTableCommand{old: "a", new: "b"}))
+		assert.Equal(
+			t,
+			alterTableCommand{"test", TableCommands{DropIndexCommand("idx"), DropColumnCommand("a")}},
+			Reverse(alterTableCommand{"test", TableCommands{
+				AddColumnCommand{Name: "a", Column: Integer{}},
+				AddIndexCommand{Name: "idx", Columns: []string{"a"}},
+			}}),
+		)
+		assert.Nil(t, Reverse(alterTableCommand{"test", TableCommands{AddColumnCommand{Name: "a", Column: Integer{}}, DropColumnCommand("b")}}))
+	})
+
+	t.Run("it reverses alter with table rename", func(t *testing.T) {
+		assert.Equal(
+			t,
+			alterTableCommand{"archive", TableCommands{DropColumnCommand("a"), RenameTableCommand("test")}},
+			Reverse(alterTableCommand{"test", TableCommands{
+				AddColumnCommand{Name: "a", Column: Integer{}},
+				RenameTableCommand("archive"),
+			}}),
+		)
+	})
+}
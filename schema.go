@@ -60,6 +60,31 @@ func (s *Schema) AlterTable(name string, c TableCommands) {
 	s.pool = append(s.pool, alterTableCommand{name, c})
 }
 
+// AlterTables makes changes on several tables, each one is altered with a separate statement.
+//
+// Example:
+//		var s migrator.Schema
+//		c := migrator.TableCommands{migrator.AddColumnCommand{Name: "tenant_id", Column: migrator.Integer{}}}
+//		s.AlterTables(migrator.BundleTables(c, "posts", "comments"))
+func (s *Schema) AlterTables(b TableBundle) {
+	s.pool = append(s.pool, b.Commands()...)
+}
+
+// BackfillColumn adds the column, fills it with the expression value and optionally makes it NOT NULL.
+//
+// Example:
+//		var s migrator.Schema
+//		s.BackfillColumn(migrator.ColumnBackfill{
+//			Table:      "users",
+//			Name:       "active",
+//			Column:     migrator.Integer{Prefix: "tiny", Nullable: migrator.Null},
+//			Expression: "1",
+//			NotNull:    migrator.Integer{Prefix: "tiny", Default: "1"},
+//		})
+func (s *Schema) BackfillColumn(b ColumnBackfill) {
+	s.pool = append(s.pool, b.Commands()...)
+}
+
 // CustomCommand allows adding the custom command to the Schema.
 //
 // Example:
@@ -75,3 +100,30 @@ func (s *Schema) AlterTable(name string, c TableCommands) {
 func (s *Schema) CustomCommand(c Command) {
 	s.pool = append(s.pool, c)
 }
+
+// ToSQLWithArgs renders schema commands with `?` placeholders instead of string default values,
+// returning one statement per command together with the values to be bound to it.
+// Nil is returned if any of the commands could not be rendered.
+// See ParameterizedColumnType for limitations.
+//
+// Example:
+//		statements, args := s.ToSQLWithArgs()
+//		for i, statement := range statements {
+//			db.Exec(statement, args[i]...)
+//		}
+func (s Schema) ToSQLWithArgs() ([]string, [][]interface{}) {
+	statements := []string{}
+	args := [][]interface{}{}
+
+	for _, c := range s.pool {
+		sql, a := ToSQLWithArgs(c)
+		if sql == "" {
+			return nil, nil
+		}
+
+		statements = append(statements, sql)
+		args = append(args, a)
+	}
+
+	return statements, args
+}
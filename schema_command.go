@@ -9,28 +9,83 @@ type Command interface {
 	ToSQL() string
 }
 
+// ParameterizedCommand is implemented by commands able to render string default values
+// as `?` placeholders, returning the values to be bound separately.
+// See ParameterizedColumnType for limitations.
+type ParameterizedCommand interface {
+	ToSQLWithArgs() (string, []interface{})
+}
+
+// ToSQLWithArgs renders the command with placeholders if it is supported by the command.
+func ToSQLWithArgs(c Command) (string, []interface{}) {
+	if p, ok := c.(ParameterizedCommand); ok {
+		return p.ToSQLWithArgs()
+	}
+
+	return c.ToSQL(), nil
+}
+
+// ConfigurableCommand is implemented by commands which rendering depends on Options.
+type ConfigurableCommand interface {
+	ToSQLWithOptions(o Options) string
+}
+
+// ToSQLWithOptions renders the command with given options if it is supported by the command.
+func ToSQLWithOptions(c Command, o Options) string {
+	if r, ok := c.(ConfigurableCommand); ok {
+		return r.ToSQLWithOptions(o)
+	}
+
+	return c.ToSQL()
+}
+
 type createTableCommand struct {
 	t Table
 }
 
 func (c createTableCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c createTableCommand) ToSQLWithOptions(o Options) string {
 	if c.t.Name == "" {
 		return ""
 	}
 
-	context := c.t.columns.render()
-	if context == "" {
-		context = "`id` bigint(20) unsigned NOT NULL AUTO_INCREMENT"
+	return c.render(o, c.t.columns.render(o))
+}
+
+// ToSQLWithArgs renders the table with `?` placeholders instead of string default values of the columns.
+// See ParameterizedColumnType for limitations.
+func (c createTableCommand) ToSQLWithArgs() (string, []interface{}) {
+	if c.t.Name == "" {
+		return "", nil
 	}
 
-	if res := c.t.indexes.render(); res != "" {
-		context += ", " + res
+	columns, args := c.t.columns.renderWithArgs()
+
+	return c.render(Options{}, columns), args
+}
+
+func (c createTableCommand) render(o Options, columns string) string {
+	definitions := []string{}
+
+	if columns != "" {
+		definitions = append(definitions, columns)
+	} else if c.t.Select == "" {
+		definitions = append(definitions, o.quoteIdentifier("id")+" bigint(20) unsigned NOT NULL AUTO_INCREMENT")
 	}
 
-	if res := c.t.foreigns.render(); res != "" {
-		context += ", " + res
+	if res := c.t.indexes.withImplicit(c.t.implicit).render(o); res != "" {
+		definitions = append(definitions, res)
 	}
 
+	if res := c.t.foreigns.render(o); res != "" {
+		definitions = append(definitions, res)
+	}
+
+	context := strings.Join(definitions, ", ")
+
 	engine := c.t.Engine
 	if engine == "" {
 		engine = "InnoDB"
@@ -50,14 +105,22 @@ func (c createTableCommand) ToSQL() string {
 		collation = charset + "_unicode_ci"
 	}
 
-	return fmt.Sprintf(
-		"CREATE TABLE `%s` (%s) ENGINE=%s DEFAULT CHARSET=%s COLLATE=%s",
-		c.t.Name,
-		context,
-		engine,
-		charset,
-		collation,
-	)
+	sql := "CREATE TABLE " + o.quoteIdentifier(c.t.Name)
+	if context != "" {
+		sql += " (" + context + ")"
+	}
+
+	sql += fmt.Sprintf(" ENGINE=%s DEFAULT CHARSET=%s COLLATE=%s", engine, charset, collation)
+
+	if c.t.Select != "" {
+		sql += " AS " + c.t.Select
+	}
+
+	return sql
+}
+
+func (c createTableCommand) Reverse() Command {
+	return dropTableCommand{table: c.t.Name, soft: true}
 }
 
 type dropTableCommand struct {
@@ -67,13 +130,17 @@ type dropTableCommand struct {
 }
 
 func (c dropTableCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c dropTableCommand) ToSQLWithOptions(o Options) string {
 	sql := "DROP TABLE"
 
 	if c.soft {
 		sql += " IF EXISTS"
 	}
 
-	sql += fmt.Sprintf(" `%s`", c.table)
+	sql += " " + o.quoteIdentifier(c.table)
 
 	var validOptions = list{"RESTRICT", "CASCADE"}
 	if validOptions.has(strings.ToUpper(c.option)) {
@@ -89,7 +156,15 @@ type renameTableCommand struct {
 }
 
 func (c renameTableCommand) ToSQL() string {
-	return fmt.Sprintf("RENAME TABLE `%s` TO `%s`", c.old, c.new)
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c renameTableCommand) ToSQLWithOptions(o Options) string {
+	return fmt.Sprintf("RENAME TABLE %s TO %s", o.quoteIdentifier(c.old), o.quoteIdentifier(c.new))
+}
+
+func (c renameTableCommand) Reverse() Command {
+	return renameTableCommand{old: c.new, new: c.old}
 }
 
 type alterTableCommand struct {
@@ -98,19 +173,57 @@ type alterTableCommand struct {
 }
 
 func (c alterTableCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c alterTableCommand) ToSQLWithOptions(o Options) string {
 	if c.name == "" || len(c.pool) == 0 {
 		return ""
 	}
 
-	return "ALTER TABLE `" + c.name + "` " + c.poolToSQL()
+	pool := c.pool.ToSQLWithOptions(o)
+	if pool == "" {
+		return ""
+	}
+
+	return "ALTER TABLE " + o.quoteIdentifier(c.name) + " " + pool
 }
 
-func (c alterTableCommand) poolToSQL() string {
-	var sql []string
+// ToSQLWithArgs renders the table commands with `?` placeholders instead of string default values.
+// See ParameterizedColumnType for limitations.
+func (c alterTableCommand) ToSQLWithArgs() (string, []interface{}) {
+	if c.name == "" || len(c.pool) == 0 {
+		return "", nil
+	}
+
+	pool, args := c.pool.ToSQLWithArgs()
+	if pool == "" {
+		return "", nil
+	}
+
+	return "ALTER TABLE " + Options{}.quoteIdentifier(c.name) + " " + pool, args
+}
+
+func (c alterTableCommand) Reverse() Command {
+	name := c.name
+	commands := TableCommands{}
+	for _, command := range c.pool {
+		if rename, ok := command.(RenameTableCommand); ok {
+			name = string(rename)
+			continue
+		}
+
+		commands = append(commands, command)
+	}
+
+	pool := commands.Reverse()
+	if pool == nil {
+		return nil
+	}
 
-	for _, tc := range c.pool {
-		sql = append(sql, tc.ToSQL())
+	if name != c.name {
+		pool = append(pool, RenameTableCommand(c.name))
 	}
 
-	return strings.Join(sql, ", ")
+	return alterTableCommand{name: name, pool: pool}
 }
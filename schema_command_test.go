@@ -172,6 +172,86 @@ func TestCreateTableCommand(t *testing.T) {
 			c.ToSQL(),
 		)
 	})
+
+	t.Run("it renders table from select", func(t *testing.T) {
+		tb := Table{Name: "test", Select: "SELECT * FROM `random`"}
+		c := createTableCommand{tb}
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `test` ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci AS SELECT * FROM `random`",
+			c.ToSQL(),
+		)
+	})
+
+	t.Run("it renders explicit columns with select", func(t *testing.T) {
+		tb := Table{
+			Name: "test",
+			columns: []column{
+				{"id", testColumnType("int NOT NULL AUTO_INCREMENT")},
+			},
+			indexes: []Key{
+				{Type: "primary", Columns: []string{"id"}},
+			},
+			Select: "SELECT `name`, `email` FROM `users`",
+		}
+		c := createTableCommand{tb}
+
+		assert.Equal(
+			t,
+			strings.Join([]string{
+				"CREATE TABLE `test` (`id` int NOT NULL AUTO_INCREMENT, PRIMARY KEY (`id`))",
+				" ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+				" AS SELECT `name`, `email` FROM `users`",
+			}, ""),
+			c.ToSQL(),
+		)
+	})
+
+	t.Run("it renders keys only with select", func(t *testing.T) {
+		tb := Table{
+			Name:    "test",
+			indexes: []Key{{Name: "idx", Columns: []string{"name"}}},
+			Select:  "SELECT `name` FROM `users`",
+		}
+		c := createTableCommand{tb}
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `test` (KEY `idx` (`name`)) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci AS SELECT `name` FROM `users`",
+			c.ToSQL(),
+		)
+	})
+}
+
+func TestCreateTableCommandToSQLWithArgs(t *testing.T) {
+	t.Run("it returns empty when table name missing", func(t *testing.T) {
+		sql, args := createTableCommand{Table{}}.ToSQLWithArgs()
+
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+
+	t.Run("it renders placeholders and collects args in order", func(t *testing.T) {
+		tb := Table{Name: "test"}
+		tb.ID("id")
+		tb.Column("status", String{Precision: 16, Default: "active"})
+		tb.Column("kind", Enum{Values: []string{"a", "b"}, Default: "b"})
+
+		sql, args := createTableCommand{tb}.ToSQLWithArgs()
+
+		assert.Equal(
+			t,
+			strings.Join([]string{
+				"CREATE TABLE `test` (`id` bigint unsigned NOT NULL AUTO_INCREMENT, ",
+				"`status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?, ",
+				"`kind` enum('a', 'b') NOT NULL DEFAULT ?, PRIMARY KEY (`id`))",
+				" ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			}, ""),
+			sql,
+		)
+		assert.Equal(t, []interface{}{"active", "b"}, args)
+	})
 }
 
 func TestDropTableCommand(t *testing.T) {
@@ -229,4 +309,64 @@ func TestAlterTableCommand(t *testing.T) {
 
 		assert.Equal(t, "ALTER TABLE `test` Do action on test, Do action on bang", c.ToSQL())
 	})
+
+	t.Run("it returns an empty command if all sub-commands are empty", func(t *testing.T) {
+		c := alterTableCommand{name: "test", pool: TableCommands{SetEngineCommand(""), SetCommentCommand("")}}
+
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it renders column changes mixed with table options", func(t *testing.T) {
+		c := alterTableCommand{
+			name: "test",
+			pool: TableCommands{
+				AddColumnCommand{Name: "x", Column: Integer{Nullable: Null}},
+				SetRowFormatCommand("dynamic"),
+				SetCommentCommand(""),
+				DropColumnCommand("y"),
+				SetEngineCommand("InnoDB"),
+				SetCharsetCommand("utf8mb4"),
+			},
+		}
+
+		assert.Equal(
+			t,
+			"ALTER TABLE `test` ADD COLUMN `x` int NULL, ENGINE=InnoDB, DEFAULT CHARSET=utf8mb4, DROP COLUMN `y`, ROW_FORMAT=DYNAMIC",
+			c.ToSQL(),
+		)
+	})
+}
+
+func TestAlterTableCommandToSQLWithArgs(t *testing.T) {
+	t.Run("it returns empty if table name is missing", func(t *testing.T) {
+		sql, args := alterTableCommand{pool: TableCommands{DropColumnCommand("test")}}.ToSQLWithArgs()
+
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+
+	t.Run("it returns empty if sub-command is invalid", func(t *testing.T) {
+		sql, args := alterTableCommand{name: "test", pool: TableCommands{ModifyColumnCommand{Name: "test"}}}.ToSQLWithArgs()
+
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+
+	t.Run("it renders placeholders and collects args", func(t *testing.T) {
+		c := alterTableCommand{
+			name: "test",
+			pool: TableCommands{
+				AddColumnCommand{Name: "status", Column: String{Precision: 16, Default: "active"}},
+				DropColumnCommand("legacy"),
+			},
+		}
+		sql, args := c.ToSQLWithArgs()
+
+		assert.Equal(
+			t,
+			"ALTER TABLE `test` ADD COLUMN `status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?, DROP COLUMN `legacy`",
+			sql,
+		)
+		assert.Equal(t, []interface{}{"active"}, args)
+	})
 }
@@ -67,6 +67,18 @@ func TestSchemaAlterTable(t *testing.T) {
 	assert.Equal(alterTableCommand{"table", TableCommands{}}, s.pool[0])
 }
 
+func TestSchemaAlterTables(t *testing.T) {
+	assert := assert.New(t)
+	c := TableCommands{testCommand("test")}
+
+	s := Schema{}
+	s.AlterTables(TableBundle{"users": c, "comments": c})
+
+	assert.Len(s.pool, 2)
+	assert.Equal(alterTableCommand{"comments", c}, s.pool[0])
+	assert.Equal(alterTableCommand{"users", c}, s.pool[1])
+}
+
 func TestSchemaCustomCommand(t *testing.T) {
 	assert := assert.New(t)
 	c := testDummyCommand("DROP PROCEDURE abc")
@@ -79,3 +91,30 @@ func TestSchemaCustomCommand(t *testing.T) {
 	assert.Len(s.pool, 1)
 	assert.Equal(c, s.pool[0])
 }
+
+func TestSchemaToSQLWithArgs(t *testing.T) {
+	t.Run("it renders statements with args", func(t *testing.T) {
+		s := Schema{}
+		s.AlterTable("users", TableCommands{AddColumnCommand{Name: "status", Column: String{Precision: 16, Default: "active"}}})
+		s.DropTable("legacy", true, "")
+
+		statements, args := s.ToSQLWithArgs()
+
+		assert.Equal(t, []string{
+			"ALTER TABLE `users` ADD COLUMN `status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?",
+			"DROP TABLE IF EXISTS `legacy`",
+		}, statements)
+		assert.Equal(t, [][]interface{}{{"active"}, nil}, args)
+	})
+
+	t.Run("it returns nil if any command is empty", func(t *testing.T) {
+		s := Schema{}
+		s.DropTable("legacy", true, "")
+		s.AlterTable("", TableCommands{DropColumnCommand("test")})
+
+		statements, args := s.ToSQLWithArgs()
+
+		assert.Nil(t, statements)
+		assert.Nil(t, args)
+	})
+}
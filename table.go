@@ -7,15 +7,57 @@ package migrator
 // - Charset	default: utf8mb4 or first part of collation (if set)
 // - Collation	default: utf8mb4_unicode_ci or charset with `_unicode_ci` suffix
 // - Comment	optional comment on table
+// - Select		optional query to populate the table (CREATE TABLE ... AS SELECT)
 type Table struct {
 	Name      string
 	columns   columns
 	indexes   keys
 	foreigns  foreigns
+	implicit  keys // foreign key indexes, rendered unless covered by declared indexes
 	Engine    string
 	Charset   string
 	Collation string
 	Comment   string
+	Select    string
+}
+
+// Up returns the schema to create the table.
+// Together with Down it allows using the table as a single source of truth for both migration directions.
+//
+// Example:
+//		posts := migrator.Table{Name: "posts"}
+//		posts.UniqueID("id")
+//
+//		var migration = migrator.Migration{
+//			Name: "19700101_0001_create_posts_table",
+//			Up:   posts.Up,
+//			Down: posts.Down,
+//		}
+func (t Table) Up() Schema {
+	var s Schema
+	s.CreateTable(t)
+
+	return s
+}
+
+// Down returns the schema to drop the table if it exists.
+func (t Table) Down() Schema {
+	var s Schema
+	s.DropTableIfExists(t.Name)
+
+	return s
+}
+
+// Validate returns the first error found in the table column definitions,
+// e.g. expression default value, which is not wrapped with parentheses.
+func (t Table) Validate() error {
+	for _, c := range t.columns {
+		if err := validateColumn(c.field, c.definition); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Column adds a column to the table
@@ -56,6 +98,17 @@ func (t *Table) Boolean(name string, def string) {
 	})
 }
 
+// BooleanWithDefault adds tinyint(1) column with the boolean default value,
+// rendered as 1/0 or TRUE/FALSE depending on Options.BooleanLiterals
+func (t *Table) BooleanWithDefault(name string, def bool) {
+	t.Column(name, Integer{
+		Prefix:         "tiny",
+		Unsigned:       true,
+		Precision:      1,
+		BooleanDefault: &def,
+	})
+}
+
 // UUID adds char(36) column
 func (t *Table) UUID(name string, def string, nullable bool) {
 	// char(36)
@@ -63,25 +116,41 @@ func (t *Table) UUID(name string, def string, nullable bool) {
 		Fixed:     true,
 		Precision: 36,
 		Default:   def,
-		Nullable:  nullable,
+		Nullable:  nullabilityOf(nullable),
 	})
 }
 
 // Timestamps adds default timestamps: `created_at` and `updated_at`
 func (t *Table) Timestamps() {
-	// created_at timestamp(6) not null default CURRENT_TIMESTAMP(6)
-	t.Column("created_at", Timable{
-		Type:      "timestamp",
-		Precision: 6,
-		Default:   "CURRENT_TIMESTAMP(6)",
-	})
-	// updated_at timestamp(6) not null default CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
-	t.Column("updated_at", Timable{
-		Type:      "timestamp",
-		Precision: 6,
-		Default:   "CURRENT_TIMESTAMP(6)",
-		OnUpdate:  "CURRENT_TIMESTAMP(6)",
-	})
+	t.Column("created_at", createdAtColumn)
+	t.Column("updated_at", updatedAtColumn)
+}
+
+// SoftDeletes adds nullable `deleted_at` timestamp to mark removed rows
+func (t *Table) SoftDeletes() {
+	t.Column("deleted_at", deletedAtColumn)
+}
+
+// created_at timestamp(6) not null default CURRENT_TIMESTAMP(6)
+var createdAtColumn = Timable{
+	Type:      "timestamp",
+	Precision: 6,
+	Default:   "CURRENT_TIMESTAMP(6)",
+}
+
+// updated_at timestamp(6) not null default CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+var updatedAtColumn = Timable{
+	Type:      "timestamp",
+	Precision: 6,
+	Default:   "CURRENT_TIMESTAMP(6)",
+	OnUpdate:  "CURRENT_TIMESTAMP(6)",
+}
+
+// deleted_at timestamp(6) null
+var deletedAtColumn = Timable{
+	Type:      "timestamp",
+	Precision: 6,
+	Nullable:  Null,
 }
 
 // Int adds int(precision) column to the table
@@ -121,12 +190,18 @@ func (t *Table) Char(name string, precision uint16) {
 
 // Text adds text column to the table
 func (t *Table) Text(name string, nullable bool) {
-	t.Column(name, Text{Nullable: nullable})
+	t.Column(name, Text{Nullable: nullabilityOf(nullable)})
+}
+
+// TextWithCharset adds text column with the character set and collation to the table,
+// e.g. to be used by a full-text index. Collation is optional.
+func (t *Table) TextWithCharset(name string, charset string, collation string, nullable bool) {
+	t.Column(name, Text{Charset: charset, Collate: collation, Nullable: nullabilityOf(nullable)})
 }
 
 // Blob adds blob column to the table
 func (t *Table) Blob(name string, nullable bool) {
-	t.Column(name, Text{Blob: true, Nullable: nullable})
+	t.Column(name, Text{Blob: true, Nullable: nullabilityOf(nullable)})
 }
 
 // JSON adds json column to the table
@@ -136,37 +211,47 @@ func (t *Table) JSON(name string) {
 
 // Timestamp adds timestamp column to the table
 func (t *Table) Timestamp(name string, nullable bool, def string) {
-	t.Column(name, Timable{Nullable: nullable, Default: def})
+	t.Column(name, Timable{Nullable: nullabilityOf(nullable), Default: def})
 }
 
 // PreciseTimestamp adds timestamp column with precision to the table
 func (t *Table) PreciseTimestamp(name string, precision uint16, nullable bool, def string) {
-	t.Column(name, Timable{Precision: precision, Nullable: nullable, Default: def})
+	t.Column(name, Timable{Precision: precision, Nullable: nullabilityOf(nullable), Default: def})
 }
 
 // Date adds date column to the table
 func (t *Table) Date(name string, nullable bool, def string) {
-	t.Column(name, Timable{Type: "date", Nullable: nullable, Default: def})
+	t.Column(name, Timable{Type: "date", Nullable: nullabilityOf(nullable), Default: def})
 }
 
 // Time adds time column to the table
 func (t *Table) Time(name string, nullable bool, def string) {
-	t.Column(name, Timable{Type: "time", Nullable: nullable, Default: def})
+	t.Column(name, Timable{Type: "time", Nullable: nullabilityOf(nullable), Default: def})
 }
 
 // Year adds year column to the table
 func (t *Table) Year(name string, nullable bool, def string) {
-	t.Column(name, Timable{Type: "year", Nullable: nullable, Default: def})
+	t.Column(name, Timable{Type: "year", Nullable: nullabilityOf(nullable), Default: def})
 }
 
 // Binary adds binary(precision) column to the table
 func (t *Table) Binary(name string, precision uint16, nullable bool) {
-	t.Column(name, Binary{Fixed: true, Precision: precision, Nullable: nullable})
+	t.Column(name, Binary{Fixed: true, Precision: precision, Nullable: nullabilityOf(nullable)})
 }
 
 // Varbinary adds varbinary(precision) column to the table
 func (t *Table) Varbinary(name string, precision uint16, nullable bool) {
-	t.Column(name, Binary{Precision: precision, Nullable: nullable})
+	t.Column(name, Binary{Precision: precision, Nullable: nullabilityOf(nullable)})
+}
+
+// Generated adds NOT NULL generated column calculated from the expression to the table.
+// Stored column could be indexed, as any other column.
+//
+// Example:
+//		t.Generated("full_name", "varchar(255)", "CONCAT(first_name, ' ', last_name)", true)
+//		t.Index("full_name_idx", "full_name")
+func (t *Table) Generated(name string, columnType string, expression string, stored bool) {
+	t.Column(name, Generated{Type: columnType, Expression: expression, Stored: stored})
 }
 
 // Primary adds primary key
@@ -207,19 +292,36 @@ func (t *Table) Index(name string, columns ...string) {
 	t.indexes = append(t.indexes, Key{Name: name, Columns: columns})
 }
 
-// Foreign adds foreign key constraints
+// InvisibleIndex adds index (key) on selected columns, which is not used by the optimizer
+func (t *Table) InvisibleIndex(name string, columns ...string) {
+	if len(columns) == 0 {
+		return
+	}
+
+	t.indexes = append(t.indexes, Key{Name: name, Columns: columns, Invisible: true})
+}
+
+// Foreign adds foreign key constraints.
+// Index on the column is rendered as well, unless one of declared indexes already starts with it.
+// Invalid foreign key (without reference or referenced table) is skipped together with its index.
 func (t *Table) Foreign(column string, reference string, on string, onUpdate string, onDelete string) {
 	name := BuildForeignNameOnTable(t.Name, column)
-	t.indexes = append(t.indexes, Key{
-		Name:    name,
-		Columns: []string{column},
-	})
-	t.foreigns = append(t.foreigns, Foreign{
+	foreign := Foreign{
 		Key:       name,
 		Column:    column,
 		Reference: reference,
 		On:        on,
 		OnUpdate:  onUpdate,
 		OnDelete:  onDelete,
+	}
+
+	if foreign.render(Options{}) == "" {
+		return
+	}
+
+	t.implicit = append(t.implicit, Key{
+		Name:    name,
+		Columns: []string{column},
 	})
+	t.foreigns = append(t.foreigns, foreign)
 }
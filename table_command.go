@@ -1,7 +1,10 @@
 package migrator
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -9,14 +12,460 @@ import (
 // https://dev.mysql.com/doc/refman/8.0/en/alter-table.html
 type TableCommands []Command
 
+// DefaultCommandsSeparator is used to join table commands
+const DefaultCommandsSeparator = ", "
+
+// tableOption is implemented by commands changing table options,
+// the order is used to render options in a canonical order.
+type tableOption interface {
+	optionOrder() int
+}
+
 func (tc TableCommands) ToSQL() string {
+	return tc.Join(DefaultCommandsSeparator)
+}
+
+// ToSQLWithOptions renders commands with given options, see Options.
+func (tc TableCommands) ToSQLWithOptions(o Options) string {
+	return tc.join(DefaultCommandsSeparator, o)
+}
+
+// Join renders commands joined with a custom separator, e.g. ",\n" for formatted output.
+// Table options are rendered in the canonical order and skipped if their value is empty,
+// so table options could be mixed with other commands in a single statement.
+// Empty string returns if any other command renders empty, as the statement would do less than requested.
+func (tc TableCommands) Join(separator string) string {
+	return tc.join(separator, Options{})
+}
+
+func (tc TableCommands) join(separator string, o Options) string {
+	rows := []string{}
+
+	for _, c := range tc.Canonical() {
+		sql := ToSQLWithOptions(c, o)
+		if sql != "" {
+			rows = append(rows, sql)
+			continue
+		}
+
+		if _, ok := c.(tableOption); !ok {
+			return ""
+		}
+	}
+
+	return strings.Join(rows, separator)
+}
+
+// Canonical returns a copy of the pool with table options placed in the canonical order:
+// ENGINE, AUTO_INCREMENT, DEFAULT CHARSET, COLLATE, ROW_FORMAT, COMPRESSION, COMMENT, INSERT_METHOD, UNION.
+// Table options only swap places among themselves, other commands keep their positions.
+func (tc TableCommands) Canonical() TableCommands {
+	result := append(TableCommands{}, tc...)
+
+	var slots []int
+	var options TableCommands
+
+	for i, c := range tc {
+		if _, ok := c.(tableOption); ok {
+			slots = append(slots, i)
+			options = append(options, c)
+		}
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].(tableOption).optionOrder() < options[j].(tableOption).optionOrder()
+	})
+
+	for i, slot := range slots {
+		result[slot] = options[i]
+	}
+
+	return result
+}
+
+// Checksum returns SHA-256 hex digest of the rendered commands in the canonical order.
+// It could be stored together with applied migration to detect later changes of the migration.
+// Commands are rendered with the default Options, so the digest does not depend on render options.
+func (tc TableCommands) Checksum() string {
+	sum := sha256.Sum256([]byte(tc.ToSQLWithOptions(Options{})))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// SplitIndexes splits the pool into several pools, so each heavy command is executed separately,
+// while consecutive cheap metadata commands stay combined. The order of commands is preserved.
+// Despite the name, not only index additions are split out: foreign key additions are heavy
+// as they build an index, column additions, drops and data type changes are heavy as they could rebuild the table.
+// ALGORITHM is copied only into pools compatible with it, see ValidateAlgorithm.
+// It helps to keep each statement within lock-wait limits on big tables.
+//
+// Example:
+//		var s migrator.Schema
+//		for _, c := range commands.SplitIndexes() {
+//			s.AlterTable("test", c)
+//		}
+func (tc TableCommands) SplitIndexes() []TableCommands {
+	var result []TableCommands
+	var group TableCommands
+	var algorithm TableCommands
+
+	for _, c := range tc {
+		if _, ok := c.(SetAlgorithmCommand); ok {
+			algorithm = append(algorithm, c)
+			continue
+		}
+
+		if !isHeavyCommand(c) {
+			group = append(group, c)
+			continue
+		}
+
+		if len(group) > 0 {
+			result = append(result, group)
+			group = nil
+		}
+
+		result = append(result, TableCommands{c})
+	}
+
+	if len(group) > 0 {
+		result = append(result, group)
+	}
+
+	for i, pool := range result {
+		withAlgorithm := append(append(TableCommands{}, pool...), algorithm...)
+		if withAlgorithm.ValidateAlgorithm() == nil {
+			result[i] = withAlgorithm
+		}
+	}
+
+	return result
+}
+
+// WithoutExistingIndexes returns a copy of the pool without index additions,
+// which names are already present in the table, so re-running the migration is safe.
+// Index names are compared case-insensitively, primary key is matched by the `PRIMARY` name.
+//
+// Example:
+//		c := migrator.TableCommands{migrator.AddIndexCommand{Name: "email_idx", Columns: []string{"email"}}}
+//		c = c.WithoutExistingIndexes("PRIMARY", "email_idx") // index addition is skipped
+func (tc TableCommands) WithoutExistingIndexes(existing ...string) TableCommands {
+	names := list{}
+	for _, name := range existing {
+		names = append(names, strings.ToLower(name))
+	}
+
+	result := TableCommands{}
+
+	for _, c := range tc {
+		name := ""
+
+		switch v := c.(type) {
+		case AddIndexCommand:
+			name = v.Name
+		case AddUniqueIndexCommand:
+			name = v.Key
+		case AddPrimaryIndexCommand:
+			name = "PRIMARY"
+		}
+
+		if name != "" && names.has(strings.ToLower(name)) {
+			continue
+		}
+
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func isHeavyCommand(c Command) bool {
+	switch v := c.(type) {
+	case AddIndexCommand, AddUniqueIndexCommand, AddPrimaryIndexCommand, AddForeignCommand:
+		return true
+	case AddColumnCommand, DropColumnCommand:
+		return true
+	case ModifyColumnCommand:
+		return !changesMetadataOnly(v.Old, v.Column)
+	case ChangeColumnCommand:
+		return !changesMetadataOnly(v.Old, v.Column)
+	}
+
+	return false
+}
+
+// ToSQLWithArgs renders commands with `?` placeholders instead of string default values,
+// returning the values in the order of appearance. Commands are rendered with the default Options.
+// See ParameterizedColumnType for limitations.
+func (tc TableCommands) ToSQLWithArgs() (string, []interface{}) {
 	rows := []string{}
+	args := []interface{}{}
+
+	for _, c := range tc.Canonical() {
+		sql, a := ToSQLWithArgs(c)
+		if sql == "" {
+			if _, ok := c.(tableOption); ok {
+				continue
+			}
+
+			return "", nil
+		}
+
+		rows = append(rows, sql)
+		args = append(args, a...)
+	}
+
+	return strings.Join(rows, DefaultCommandsSeparator), args
+}
+
+// Validate returns the first error found in the commands pool,
+// including commands which are not compatible with the requested algorithm.
+func (tc TableCommands) Validate() error {
+	for _, c := range tc {
+		if err := Validate(c); err != nil {
+			return err
+		}
+	}
+
+	if err := tc.ValidateAlgorithm(); err != nil {
+		return err
+	}
+
+	return tc.ValidateRename()
+}
+
+// changesMetadataOnly checks that the new definition differs from the old one only by the comment
+// or by enum values appended within the same storage size, so the table data is not rebuilt.
+// Custom column types are always treated as data type changes.
+func changesMetadataOnly(old ColumnType, column ColumnType) bool {
+	if old == nil || column == nil {
+		return false
+	}
+
+	old, column = withComment(old, ""), withComment(column, "")
+	if old == nil || column == nil {
+		return false
+	}
+
+	if o, ok := old.(Enum); ok {
+		if c, ok := column.(Enum); ok && o.Multiple == c.Multiple && o.storageSize() == c.storageSize() {
+			if _, moved := movedEnumValue(o.Values, c.Values); !moved {
+				o.Values = c.Values
+				old = o
+			}
+		}
+	}
+
+	return old.BuildRow() == column.BuildRow()
+}
+
+// supportsInstant checks that the command qualifies for ALGORITHM=INSTANT since MySQL 8.0.12
+func supportsInstant(c Command) bool {
+	switch v := c.(type) {
+	case AddColumnCommand:
+		if g, ok := v.Column.(Generated); ok && !g.Stored {
+			return true
+		}
+
+		info, _ := describeColumn(v.Column)
+		return v.After == "" && !v.First && !info.generated && !info.autoincrement
+	case RenameTableCommand, RenameIndexCommand, SetAlgorithmCommand:
+		return true
+	}
+
+	return false
+}
+
+// ValidateRename checks that partition maintenance is executed in a separate statement:
+// COALESCE and REORGANIZE PARTITION could be combined only with ALGORITHM,
+// REMOVE PARTITIONING follows other alter specifications without a comma, so it should be the only command.
+// RENAME TO could be combined with any other command.
+func (tc TableCommands) ValidateRename() error {
+	for i, c := range tc {
+		switch c.(type) {
+		case ReorganizePartitionCommand, CoalescePartitionCommand, RemovePartitioningCommand:
+		default:
+			continue
+		}
+
+		for j, other := range tc {
+			if i == j {
+				continue
+			}
+
+			if _, ok := other.(SetAlgorithmCommand); ok {
+				if _, ok := c.(RemovePartitioningCommand); !ok {
+					continue
+				}
+			}
+
+			if _, ok := other.(RenameTableCommand); ok {
+				return fmt.Errorf("RENAME TO with `%s`: %w", c.ToSQL(), ErrIncompatibleRename)
+			}
+
+			return fmt.Errorf("`%s` with `%s`: %w", c.ToSQL(), other.ToSQL(), ErrStandalonePartitioning)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAlgorithm checks that every command in the pool could be executed with the requested ALGORITHM,
+// as the whole statement fails otherwise. Column modifications are treated as data type changes,
+// unless the old definition is known and only the comment is changed or enum values are appended.
+// INPLACE does not qualify adding stored generated columns, foreign keys and changing the engine.
+// INSTANT qualifies adding the last column (except stored generated and auto_increment ones),
+// adding a virtual column and renaming the table or index.
+//
+// Example:
+//		c := migrator.TableCommands{migrator.SetAlgorithmCommand("inplace"), migrator.ModifyColumnCommand{...}}
+//		err := c.ValidateAlgorithm() // MODIFY requires ALGORITHM=COPY
+func (tc TableCommands) ValidateAlgorithm() error {
+	algorithm := ""
+	addsPrimary := false
+
+	for _, c := range tc {
+		switch v := c.(type) {
+		case SetAlgorithmCommand:
+			algorithm = strings.ToUpper(string(v))
+		case AddPrimaryIndexCommand:
+			addsPrimary = true
+		}
+	}
+
+	if algorithm != "INPLACE" && algorithm != "INSTANT" {
+		return nil
+	}
+
+	for _, c := range tc {
+		compatible := true
+		if algorithm == "INSTANT" {
+			compatible = supportsInstant(c)
+		}
+
+		switch v := c.(type) {
+		case AddColumnCommand:
+			if g, ok := v.Column.(Generated); ok && g.Stored {
+				// stored generated column is computed for every row
+				compatible = false
+			}
+		case SetEngineCommand:
+			// only the rebuild of InnoDB table is executed in place
+			compatible = compatible && strings.EqualFold(string(v), "InnoDB")
+		case ModifyColumnCommand:
+			compatible = changesMetadataOnly(v.Old, v.Column)
+		case ChangeColumnCommand:
+			compatible = changesMetadataOnly(v.Old, v.Column)
+		case AddForeignCommand:
+			// INPLACE is supported only with disabled foreign_key_checks
+			compatible = false
+		case DropPrimaryIndexCommand:
+			compatible = compatible && addsPrimary
+		}
+
+		if !compatible {
+			return fmt.Errorf("ALGORITHM=%s, `%s`: %w", algorithm, c.ToSQL(), ErrIncompatibleAlgorithm)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAutoincrementKeys checks that auto_increment columns are still covered by a key
+// when the primary key is dropped. The existing argument lists keys of the table before the change,
+// the autoincrement argument lists existing auto_increment columns. Keys and columns added, changed
+// or dropped within the pool are taken into account. Column names are compared case-insensitively.
+//
+// Example:
+//		c := migrator.TableCommands{migrator.DropPrimaryIndexCommand{}, migrator.AddPrimaryIndexCommand("uuid")}
+//		err := c.ValidateAutoincrementKeys(nil, "id") // id is left without a key
+//		err = c.ValidateAutoincrementKeys([]migrator.Key{{Name: "id_unique", Type: "unique", Columns: []string{"id"}}}, "id") // nil
+func (tc TableCommands) ValidateAutoincrementKeys(existing []Key, autoincrement ...string) error {
+	dropsPrimary := false
+	columns := append([]string{}, autoincrement...)
+	covered := keys{}
+
+	for _, key := range existing {
+		if !strings.EqualFold(key.Type, "primary") && !strings.EqualFold(key.Name, "primary") {
+			covered = append(covered, key)
+		}
+	}
+
+	for _, c := range tc {
+		switch v := c.(type) {
+		case DropPrimaryIndexCommand:
+			dropsPrimary = true
+		case DropIndexCommand:
+			covered = covered.without(string(v))
+		case AddPrimaryIndexCommand:
+			covered = append(covered, Key{Name: "PRIMARY", Columns: []string{string(v)}})
+		case AddIndexCommand:
+			covered = append(covered, Key{Name: v.Name, Columns: []string{firstKeyColumn(v.Columns, v.Parts)}})
+		case AddUniqueIndexCommand:
+			covered = append(covered, Key{Name: v.Key, Columns: []string{firstKeyColumn(v.Columns, v.Parts)}})
+		case DropColumnCommand:
+			columns = withoutColumn(columns, string(v))
+		case ModifyColumnCommand:
+			columns = withoutColumn(columns, v.Name)
+			if info, _ := describeColumn(v.Column); info.autoincrement {
+				columns = append(columns, v.Name)
+			}
+		case ChangeColumnCommand:
+			columns = withoutColumn(columns, v.From)
+			if info, _ := describeColumn(v.Column); info.autoincrement {
+				columns = append(columns, v.To)
+			}
+		}
+	}
+
+	if !dropsPrimary {
+		return nil
+	}
+
+	for _, column := range columns {
+		if !covered.covers([]string{column}) {
+			return fmt.Errorf("column `%s`: %w", column, ErrOrphanedAutoincrement)
+		}
+	}
+
+	return nil
+}
+
+func withoutColumn(columns []string, name string) []string {
+	result := []string{}
+
+	for _, column := range columns {
+		if !strings.EqualFold(column, name) {
+			result = append(result, column)
+		}
+	}
+
+	return result
+}
+
+func firstKeyColumn(columns []string, parts []KeyPart) string {
+	if len(columns) > 0 {
+		return columns[0]
+	}
+
+	if len(parts) > 0 {
+		return parts[0].Column
+	}
+
+	return ""
+}
+
+// RequiredFeatures returns unique capabilities required by all commands in the pool.
+func (tc TableCommands) RequiredFeatures() []Feature {
+	var features []Feature
 
 	for _, c := range tc {
-		rows = append(rows, c.ToSQL())
+		for _, f := range RequiredFeatures(c) {
+			features = appendFeature(features, f)
+		}
 	}
 
-	return strings.Join(rows, ", ")
+	return features
 }
 
 // AddColumnCommand is a command to add the column to the table.
@@ -28,19 +477,52 @@ type AddColumnCommand struct {
 }
 
 func (c AddColumnCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddColumnCommand) ToSQLWithOptions(o Options) string {
 	if c.Column == nil {
 		return ""
 	}
 
-	definition := c.Column.BuildRow()
+	return c.render(o, buildRowWithOptions(c.Column, o))
+}
+
+func (c AddColumnCommand) Reverse() Command {
+	if c.Name == "" {
+		return nil
+	}
+
+	return DropColumnCommand(c.Name)
+}
+
+func (c AddColumnCommand) ToSQLWithArgs() (string, []interface{}) {
+	if c.Column == nil {
+		return "", nil
+	}
+
+	definition, args := buildRowWithArgs(c.Column)
+	sql := c.render(Options{}, definition)
+	if sql == "" {
+		return "", nil
+	}
+
+	return sql, args
+}
+
+func (c AddColumnCommand) render(o Options, definition string) string {
 	if c.Name == "" || definition == "" {
 		return ""
 	}
 
-	sql := "ADD COLUMN `" + c.Name + "` " + definition
+	if c.After != "" && !isSimpleIdentifier(c.After) {
+		return ""
+	}
+
+	sql := "ADD COLUMN " + o.quoteIdentifier(c.Name) + " " + definition
 
 	if c.After != "" {
-		sql += " AFTER " + c.After
+		sql += " AFTER " + o.quoteIdentifier(c.After)
 	} else if c.First {
 		sql += " FIRST"
 	}
@@ -48,6 +530,100 @@ func (c AddColumnCommand) ToSQL() string {
 	return sql
 }
 
+// Validate checks that the column is positioned after a simple column name,
+// expression default is valid and NOT NULL column has a default value,
+// otherwise adding it to the non-empty table fails.
+func (c AddColumnCommand) Validate() error {
+	if c.After != "" && !isSimpleIdentifier(c.After) {
+		return fmt.Errorf("column `%s` after %q: %w", c.Name, c.After, ErrInvalidColumnPosition)
+	}
+
+	if err := validateColumn(c.Name, c.Column); err != nil {
+		return err
+	}
+
+	info, ok := describeColumn(c.Column)
+	if !ok || info.nullable || info.def != "" || info.autoincrement || info.generated {
+		return nil
+	}
+
+	return fmt.Errorf("column `%s`: %w", c.Name, ErrNotNullWithoutDefault)
+}
+
+func (c AddColumnCommand) RequiredFeatures() []Feature {
+	return columnFeatures(c.Column)
+}
+
+// WithName returns a copy of the command with the new column name.
+func (c AddColumnCommand) WithName(name string) AddColumnCommand {
+	c.Name = name
+	return c
+}
+
+// WithColumn returns a copy of the command with the new column definition.
+func (c AddColumnCommand) WithColumn(column ColumnType) AddColumnCommand {
+	c.Column = column
+	return c
+}
+
+// WithAfter returns a copy of the command positioned after another column.
+func (c AddColumnCommand) WithAfter(after string) AddColumnCommand {
+	c.After = after
+	return c
+}
+
+// WithFirst returns a copy of the command with the first flag changed.
+func (c AddColumnCommand) WithFirst(first bool) AddColumnCommand {
+	c.First = first
+	return c
+}
+
+func validateColumn(name string, column ColumnType) error {
+	info, _ := describeColumn(column)
+	if err := validateDefaultExpression(info.def); err != nil {
+		return fmt.Errorf("column `%s`: %w", name, err)
+	}
+
+	if t, ok := column.(Text); ok {
+		if err := t.validateCharset(); err != nil {
+			return fmt.Errorf("column `%s`: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// columnFeatures returns capabilities required by the column definition
+func columnFeatures(column ColumnType) []Feature {
+	if info, _ := describeColumn(column); info.invisible {
+		return []Feature{FeatureInvisibleColumn}
+	}
+
+	return nil
+}
+
+// AddTimestamps builds commands to add default timestamps: `created_at` and `updated_at`.
+//
+// Example:
+//		migrator.AddTimestamps()
+//			↪️ ADD COLUMN `created_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+//			   ADD COLUMN `updated_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)
+func AddTimestamps() TableCommands {
+	return TableCommands{
+		AddColumnCommand{Name: "created_at", Column: createdAtColumn},
+		AddColumnCommand{Name: "updated_at", Column: updatedAtColumn},
+	}
+}
+
+// AddSoftDeletes builds the command to add nullable `deleted_at` timestamp to mark removed rows.
+//
+// Example:
+//		migrator.AddSoftDeletes()
+//			↪️ ADD COLUMN `deleted_at` timestamp(6) NULL
+func AddSoftDeletes() TableCommands {
+	return TableCommands{AddColumnCommand{Name: "deleted_at", Column: deletedAtColumn}}
+}
+
 // RenameColumnCommand is a command to rename a column in the table.
 // Warning ⚠️ BC incompatible!
 //
@@ -58,141 +634,556 @@ type RenameColumnCommand struct {
 }
 
 func (c RenameColumnCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c RenameColumnCommand) ToSQLWithOptions(o Options) string {
 	if c.Old == "" || c.New == "" {
 		return ""
 	}
 
-	return fmt.Sprintf("RENAME COLUMN `%s` TO `%s`", c.Old, c.New)
+	return fmt.Sprintf("RENAME COLUMN %s TO %s", o.quoteIdentifier(c.Old), o.quoteIdentifier(c.New))
+}
+
+func (c RenameColumnCommand) Reverse() Command {
+	return RenameColumnCommand{Old: c.New, New: c.Old}
+}
+
+func (c RenameColumnCommand) RequiredFeatures() []Feature {
+	return []Feature{FeatureRenameColumn}
+}
+
+// WithOld returns a copy of the command with the new source column name.
+func (c RenameColumnCommand) WithOld(old string) RenameColumnCommand {
+	c.Old = old
+	return c
+}
+
+// WithNew returns a copy of the command with the new target column name.
+func (c RenameColumnCommand) WithNew(new string) RenameColumnCommand {
+	c.New = new
+	return c
 }
 
 // ModifyColumnCommand is a command to modify column type.
+// Old definition is not rendered, it is used to validate the requested algorithm and to reverse the command.
 // Warning ⚠️ BC incompatible!
 //
 // Info ℹ️ extension for Oracle compatibility.
 type ModifyColumnCommand struct {
 	Name   string
 	Column ColumnType
+	Old    ColumnType
 }
 
 func (c ModifyColumnCommand) ToSQL() string {
-	if c.Column == nil {
-		return ""
-	}
+	return c.ToSQLWithOptions(Options{})
+}
 
-	definition := c.Column.BuildRow()
-	if c.Name == "" || definition == "" {
+func (c ModifyColumnCommand) ToSQLWithOptions(o Options) string {
+	if c.Column == nil {
 		return ""
 	}
 
-	return fmt.Sprintf("MODIFY `%s` %s", c.Name, definition)
-}
-
-// ChangeColumnCommand is a default command to change column.
-// Warning ⚠️ BC incompatible!
-type ChangeColumnCommand struct {
-	From   string
-	To     string
-	Column ColumnType
+	return c.render(o, buildRowWithOptions(c.Column, o))
 }
 
-func (c ChangeColumnCommand) ToSQL() string {
+func (c ModifyColumnCommand) ToSQLWithArgs() (string, []interface{}) {
 	if c.Column == nil {
-		return ""
+		return "", nil
 	}
 
-	definition := c.Column.BuildRow()
-	if c.From == "" || c.To == "" || definition == "" {
-		return ""
+	definition, args := buildRowWithArgs(c.Column)
+	sql := c.render(Options{}, definition)
+	if sql == "" {
+		return "", nil
 	}
 
-	return fmt.Sprintf("CHANGE `%s` `%s` %s", c.From, c.To, c.Column.BuildRow())
+	return sql, args
 }
 
-// DropColumnCommand is a command to drop a column from the table.
-// Warning ⚠️ BC incompatible!
-type DropColumnCommand string
-
-// Info ℹ️ campatible with Oracle
-func (c DropColumnCommand) ToSQL() string {
-	if c == "" {
+func (c ModifyColumnCommand) render(o Options, definition string) string {
+	if c.Name == "" || definition == "" {
 		return ""
 	}
 
-	return fmt.Sprintf("DROP COLUMN `%s`", c)
-}
-
-// AddIndexCommand adds a key to the table.
-type AddIndexCommand struct {
-	Name    string
-	Columns []string
+	return fmt.Sprintf("MODIFY %s %s", o.quoteIdentifier(c.Name), definition)
 }
 
-func (c AddIndexCommand) ToSQL() string {
-	if c.Name == "" || len(c.Columns) == 0 {
-		return ""
+// Reverse restores the old definition, nil returns if it is unknown.
+func (c ModifyColumnCommand) Reverse() Command {
+	if c.Old == nil {
+		return nil
 	}
 
-	return fmt.Sprintf("ADD KEY `%s` (`%s`)", c.Name, strings.Join(c.Columns, "`, `"))
+	return ModifyColumnCommand{Name: c.Name, Column: c.Old, Old: c.Column}
 }
 
-// DropIndexCommand removes the key from the table.
-type DropIndexCommand string
+// Validate checks that expression default of the column is valid.
+func (c ModifyColumnCommand) Validate() error {
+	return validateColumn(c.Name, c.Column)
+}
 
-func (c DropIndexCommand) ToSQL() string {
-	if c == "" {
-		return ""
-	}
+func (c ModifyColumnCommand) RequiredFeatures() []Feature {
+	return columnFeatures(c.Column)
+}
 
-	return fmt.Sprintf("DROP KEY `%s`", c)
+// WithName returns a copy of the command with the new column name.
+func (c ModifyColumnCommand) WithName(name string) ModifyColumnCommand {
+	c.Name = name
+	return c
 }
 
-// AddForeignCommand adds the foreign key constraint to the table.
-type AddForeignCommand struct {
-	Foreign Foreign
+// WithColumn returns a copy of the command with the new column definition.
+func (c ModifyColumnCommand) WithColumn(column ColumnType) ModifyColumnCommand {
+	c.Column = column
+	return c
 }
 
-func (c AddForeignCommand) ToSQL() string {
-	if c.Foreign.render() == "" {
-		return ""
+// ModifyColumnComment builds the command changing only the comment of the column.
+// The existing column definition should be passed to keep everything else as is,
+// so MySQL could apply the change in place without rebuilding the table data.
+// An empty string renders for custom column types.
+//
+// Example:
+//		migrator.ModifyColumnComment("email", migrator.String{Precision: 255}, "login")
+//			↪️ MODIFY `email` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL COMMENT 'login'
+func ModifyColumnComment(name string, column ColumnType, comment string) ModifyColumnCommand {
+	return ModifyColumnCommand{Name: name, Column: withComment(column, comment), Old: column}
+}
+
+// ModifySpatialSRID builds the command setting the SRID of the spatial column, empty SRID clears it.
+// The existing column definition should be passed to re-specify the geometry type.
+// All existing values should have the new SRID, otherwise the statement fails.
+// An empty string renders for non-numeric SRID.
+//
+// Info ℹ️ available since MySQL 8.0.3
+//
+// Example:
+//		migrator.ModifySpatialSRID("location", migrator.Spatial{Type: "point"}, "4326")
+//			↪️ MODIFY `location` point NOT NULL SRID 4326
+func ModifySpatialSRID(name string, column Spatial, srid string) ModifyColumnCommand {
+	if srid != "" && !isNumeric(srid) {
+		return ModifyColumnCommand{Name: name}
 	}
 
-	return "ADD " + c.Foreign.render()
+	column.SRID = srid
+
+	return ModifyColumnCommand{Name: name, Column: column}
 }
 
-// DropForeignCommand is a command to remove a foreign key constraint.
-type DropForeignCommand string
+// ModifyEnumValues builds the command changing the list of enum values of the column.
+// ENUM values are stored by their position, so existing values should keep their positions
+// and new values should be appended. The command is returned together with ErrEnumReorder otherwise,
+// as stored data would be silently remapped.
+//
+// Example:
+//		migrator.ModifyEnumValues("status", []string{"on", "off"}, migrator.Enum{Values: []string{"on", "off", "auto"}})
+//			↪️ MODIFY `status` enum('on', 'off', 'auto') NOT NULL
+func ModifyEnumValues(name string, old []string, column Enum) (ModifyColumnCommand, error) {
+	previous := column
+	previous.Values = old
 
-func (c DropForeignCommand) ToSQL() string {
-	if c == "" {
-		return ""
+	c := ModifyColumnCommand{Name: name, Column: column, Old: previous}
+
+	if value, moved := movedEnumValue(old, column.Values); moved {
+		return c, fmt.Errorf("column `%s` value '%s': %w", name, value, ErrEnumReorder)
 	}
 
-	return fmt.Sprintf("DROP FOREIGN KEY `%s`", c)
+	return c, nil
 }
 
-// AddUniqueIndexCommand is a command to add a unique key to the table on some columns.
-type AddUniqueIndexCommand struct {
-	Key     string
-	Columns []string
+// movedEnumValue returns the first old value which does not keep its position in the new values
+func movedEnumValue(old []string, values []string) (string, bool) {
+	for i, value := range old {
+		if i >= len(values) || values[i] != value {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// ChangeColumnCommand is a default command to change column.
+// Old definition is not rendered, it is used to validate the requested algorithm.
+// Warning ⚠️ BC incompatible!
+type ChangeColumnCommand struct {
+	From   string
+	To     string
+	Column ColumnType
+	Old    ColumnType
+}
+
+func (c ChangeColumnCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c ChangeColumnCommand) ToSQLWithOptions(o Options) string {
+	if c.Column == nil {
+		return ""
+	}
+
+	return c.render(o, buildRowWithOptions(c.Column, o))
+}
+
+func (c ChangeColumnCommand) ToSQLWithArgs() (string, []interface{}) {
+	if c.Column == nil {
+		return "", nil
+	}
+
+	definition, args := buildRowWithArgs(c.Column)
+	sql := c.render(Options{}, definition)
+	if sql == "" {
+		return "", nil
+	}
+
+	return sql, args
+}
+
+func (c ChangeColumnCommand) render(o Options, definition string) string {
+	if c.From == "" || c.To == "" || definition == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("CHANGE %s %s %s", o.quoteIdentifier(c.From), o.quoteIdentifier(c.To), definition)
+}
+
+// Validate checks that expression default of the column is valid.
+func (c ChangeColumnCommand) Validate() error {
+	return validateColumn(c.To, c.Column)
+}
+
+func (c ChangeColumnCommand) RequiredFeatures() []Feature {
+	return columnFeatures(c.Column)
+}
+
+// WithFrom returns a copy of the command with the new source column name.
+func (c ChangeColumnCommand) WithFrom(from string) ChangeColumnCommand {
+	c.From = from
+	return c
+}
+
+// WithTo returns a copy of the command with the new target column name.
+func (c ChangeColumnCommand) WithTo(to string) ChangeColumnCommand {
+	c.To = to
+	return c
+}
+
+// WithColumn returns a copy of the command with the new column definition.
+func (c ChangeColumnCommand) WithColumn(column ColumnType) ChangeColumnCommand {
+	c.Column = column
+	return c
+}
+
+// DropColumnCommand is a command to drop a column from the table.
+// Warning ⚠️ BC incompatible!
+type DropColumnCommand string
+
+// Info ℹ️ campatible with Oracle
+func (c DropColumnCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c DropColumnCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "DROP COLUMN " + o.quoteIdentifier(string(c))
+}
+
+// AddIndexCommand adds a key to the table.
+//
+// Parts are appended after Columns and allow functional or multi-valued key parts:
+//		migrator.AddIndexCommand{Name: "tags_idx", Parts: []migrator.KeyPart{
+//			{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+//		}}
+//			↪️ ADD KEY `tags_idx` ((CAST(data->'$.tags' AS CHAR(64) ARRAY)))
+type AddIndexCommand struct {
+	Name      string
+	Columns   []string
+	Parts     []KeyPart
+	Invisible bool
+
+	// ExplicitAsc emits ASC for ascending key parts, as SHOW CREATE TABLE does on some versions
+	ExplicitAsc bool
+}
+
+func (c AddIndexCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddIndexCommand) ToSQLWithOptions(o Options) string {
+	if c.Name == "" || len(c.Columns)+len(c.Parts) == 0 {
+		return ""
+	}
+
+	parts := append(columnsToKeyParts(c.Columns), c.Parts...).renderOrdered(o, c.ExplicitAsc)
+	if parts == "" {
+		return ""
+	}
+
+	sql := fmt.Sprintf("ADD KEY %s (%s)", o.quoteIdentifier(c.Name), parts)
+	if c.Invisible {
+		sql += " INVISIBLE"
+	}
+
+	return sql
+}
+
+func (c AddIndexCommand) Reverse() Command {
+	if c.Name == "" {
+		return nil
+	}
+
+	return DropIndexCommand(c.Name)
+}
+
+func (c AddIndexCommand) RequiredFeatures() []Feature {
+	features := keyParts(c.Parts).requiredFeatures()
+	if c.Invisible {
+		features = appendFeature(features, FeatureInvisibleIndex)
+	}
+
+	return features
+}
+
+// WithName returns a copy of the command with the new index name.
+func (c AddIndexCommand) WithName(name string) AddIndexCommand {
+	c.Name = name
+	return c
+}
+
+// WithColumns returns a copy of the command with the new list of columns.
+func (c AddIndexCommand) WithColumns(columns ...string) AddIndexCommand {
+	c.Columns = append([]string{}, columns...)
+	return c
+}
+
+// WithParts returns a copy of the command with the new list of key parts.
+func (c AddIndexCommand) WithParts(parts ...KeyPart) AddIndexCommand {
+	c.Parts = append([]KeyPart{}, parts...)
+	return c
+}
+
+// DropIndexCommand removes the key from the table.
+type DropIndexCommand string
+
+func (c DropIndexCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c DropIndexCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "DROP KEY " + o.quoteIdentifier(string(c))
+}
+
+// RenameIndexCommand is a command to rename the key (index).
+// `RENAME KEY` alias is used instead of `RENAME INDEX` when Key flag is set.
+type RenameIndexCommand struct {
+	Old string
+	New string
+	Key bool
+}
+
+func (c RenameIndexCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c RenameIndexCommand) ToSQLWithOptions(o Options) string {
+	if c.Old == "" || c.New == "" || c.Validate() != nil {
+		return ""
+	}
+
+	keyword := "INDEX"
+	if c.Key {
+		keyword = "KEY"
+	}
+
+	return fmt.Sprintf("RENAME %s %s TO %s", keyword, o.quoteIdentifier(c.Old), o.quoteIdentifier(c.New))
+}
+
+// Validate checks that the primary key is not renamed, as its name is fixed.
+func (c RenameIndexCommand) Validate() error {
+	if strings.EqualFold(c.Old, "PRIMARY") || strings.EqualFold(c.New, "PRIMARY") {
+		return ErrRenamePrimaryKey
+	}
+
+	return nil
+}
+
+func (c RenameIndexCommand) Reverse() Command {
+	return RenameIndexCommand{Old: c.New, New: c.Old, Key: c.Key}
+}
+
+func (c RenameIndexCommand) RequiredFeatures() []Feature {
+	return []Feature{FeatureRenameIndex}
+}
+
+// AddForeignCommand adds the foreign key constraint to the table.
+type AddForeignCommand struct {
+	Foreign Foreign
+}
+
+func (c AddForeignCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddForeignCommand) ToSQLWithOptions(o Options) string {
+	if c.Foreign.render(o) == "" {
+		return ""
+	}
+
+	return "ADD " + c.Foreign.render(o)
+}
+
+func (c AddForeignCommand) Reverse() Command {
+	if c.Foreign.Key == "" {
+		return nil
+	}
+
+	return DropForeignCommand(c.Foreign.Key)
+}
+
+// Validate checks that the foreign key references the columns on the parent table.
+func (c AddForeignCommand) Validate() error {
+	return c.Foreign.validate()
+}
+
+// WithForeign returns a copy of the command with the new foreign key definition.
+func (c AddForeignCommand) WithForeign(f Foreign) AddForeignCommand {
+	c.Foreign = f
+	return c
+}
+
+// DropForeignCommand is a command to remove a foreign key constraint.
+type DropForeignCommand string
+
+func (c DropForeignCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c DropForeignCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "DROP FOREIGN KEY " + o.quoteIdentifier(string(c))
+}
+
+// DropForeignWithIndex builds commands to remove the foreign key together with its backing index,
+// which is not removed automatically. Index name defaults to the foreign key name.
+//
+// Example:
+//		migrator.DropForeignWithIndex("posts_user_id_foreign", "")
+//			↪️ DROP FOREIGN KEY `posts_user_id_foreign`, DROP KEY `posts_user_id_foreign`
+func DropForeignWithIndex(key string, index string) TableCommands {
+	if key == "" {
+		return nil
+	}
+
+	if index == "" {
+		index = key
+	}
+
+	return TableCommands{DropForeignCommand(key), DropIndexCommand(index)}
+}
+
+// AddUniqueIndexCommand is a command to add a unique key to the table on some columns.
+//
+// Parts are appended after Columns and allow prefixed or ordered key parts:
+//		migrator.AddUniqueIndexCommand{Key: "u", Parts: []migrator.KeyPart{
+//			{Column: "a", Length: 20, Direction: "desc"},
+//			{Column: "b"},
+//		}}
+//			↪️ ADD UNIQUE KEY `u` (`a`(20) DESC, `b`)
+type AddUniqueIndexCommand struct {
+	Key       string
+	Columns   []string
+	Parts     []KeyPart
+	Invisible bool
+
+	// ExplicitAsc emits ASC for ascending key parts, as SHOW CREATE TABLE does on some versions
+	ExplicitAsc bool
 }
 
 func (c AddUniqueIndexCommand) ToSQL() string {
-	if c.Key == "" || len(c.Columns) == 0 {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddUniqueIndexCommand) ToSQLWithOptions(o Options) string {
+	if c.Key == "" || len(c.Columns)+len(c.Parts) == 0 {
+		return ""
+	}
+
+	parts := append(columnsToKeyParts(c.Columns), c.Parts...).renderOrdered(o, c.ExplicitAsc)
+	if parts == "" {
 		return ""
 	}
 
-	return fmt.Sprintf("ADD UNIQUE KEY `%s` (`%s`)", c.Key, strings.Join(c.Columns, "`, `"))
+	sql := fmt.Sprintf("ADD UNIQUE KEY %s (%s)", o.quoteIdentifier(c.Key), parts)
+	if c.Invisible {
+		sql += " INVISIBLE"
+	}
+
+	return sql
+}
+
+func (c AddUniqueIndexCommand) Reverse() Command {
+	if c.Key == "" {
+		return nil
+	}
+
+	return DropIndexCommand(c.Key)
+}
+
+func (c AddUniqueIndexCommand) RequiredFeatures() []Feature {
+	features := keyParts(c.Parts).requiredFeatures()
+	if c.Invisible {
+		features = appendFeature(features, FeatureInvisibleIndex)
+	}
+
+	return features
+}
+
+// WithKey returns a copy of the command with the new key name.
+func (c AddUniqueIndexCommand) WithKey(key string) AddUniqueIndexCommand {
+	c.Key = key
+	return c
+}
+
+// WithColumns returns a copy of the command with the new list of columns.
+func (c AddUniqueIndexCommand) WithColumns(columns ...string) AddUniqueIndexCommand {
+	c.Columns = append([]string{}, columns...)
+	return c
+}
+
+// WithParts returns a copy of the command with the new list of key parts.
+func (c AddUniqueIndexCommand) WithParts(parts ...KeyPart) AddUniqueIndexCommand {
+	c.Parts = append([]KeyPart{}, parts...)
+	return c
 }
 
 // AddPrimaryIndexCommand is a command to add a primary key.
 type AddPrimaryIndexCommand string
 
 func (c AddPrimaryIndexCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddPrimaryIndexCommand) ToSQLWithOptions(o Options) string {
 	if c == "" {
 		return ""
 	}
 
-	return fmt.Sprintf("ADD PRIMARY KEY (`%s`)", c)
+	return "ADD PRIMARY KEY (" + o.quoteIdentifier(string(c)) + ")"
+}
+
+func (c AddPrimaryIndexCommand) Reverse() Command {
+	return DropPrimaryIndexCommand{}
 }
 
 // DropPrimaryIndexCommand is a command to remove the primary key from the table.
@@ -202,6 +1193,445 @@ func (c DropPrimaryIndexCommand) ToSQL() string {
 	return "DROP PRIMARY KEY"
 }
 
+// AddCheckCommand is a command to add the check constraint to the table.
+// Name is optional, MySQL generates one if it is missing.
+// NotNullColumn builds the `column IS NOT NULL` expression instead of Expression.
+type AddCheckCommand struct {
+	Name          string
+	Expression    string
+	NotEnforced   bool
+	NotNullColumn string
+}
+
+func (c AddCheckCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddCheckCommand) ToSQLWithOptions(o Options) string {
+	expression := c.expression(o)
+	if expression == "" {
+		return ""
+	}
+
+	sql := "ADD "
+	if c.Name != "" {
+		sql += "CONSTRAINT " + o.quoteIdentifier(c.Name) + " "
+	}
+
+	sql += "CHECK " + expression
+
+	if c.NotEnforced {
+		sql += " NOT ENFORCED"
+	}
+
+	return sql
+}
+
+// expression returns the expression wrapped with a single pair of parentheses,
+// already parenthesized expression is kept as is. Empty string returns for empty expression.
+func (c AddCheckCommand) expression(o Options) string {
+	if c.NotNullColumn != "" {
+		return "(" + o.quoteIdentifier(c.NotNullColumn) + " IS NOT NULL)"
+	}
+
+	expression := strings.TrimSpace(c.Expression)
+	if !isWrappedInParentheses(expression) {
+		expression = "(" + expression + ")"
+	}
+
+	if strings.TrimSpace(expression[1:len(expression)-1]) == "" {
+		return ""
+	}
+
+	return expression
+}
+
+func (c AddCheckCommand) Validate() error {
+	if c.expression(Options{}) == "" {
+		return ErrEmptyCheckExpression
+	}
+
+	return nil
+}
+
+func (c AddCheckCommand) Reverse() Command {
+	if c.Name == "" {
+		return nil
+	}
+
+	return DropCheckCommand(c.Name)
+}
+
+func (c AddCheckCommand) RequiredFeatures() []Feature {
+	return []Feature{FeatureCheckConstraint}
+}
+
+// DropCheckCommand is a command to remove the check constraint.
+type DropCheckCommand string
+
+func (c DropCheckCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c DropCheckCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "DROP CHECK " + o.quoteIdentifier(string(c))
+}
+
+func (c DropCheckCommand) RequiredFeatures() []Feature {
+	return []Feature{FeatureCheckConstraint}
+}
+
+// BuildImplicitCheckNameOnTable builds a name MySQL generates for unnamed check constraint,
+// ordinal is a 1-based position of the unnamed check constraint on the table.
+//
+// Example:
+//		migrator.DropCheckCommand(migrator.BuildImplicitCheckNameOnTable("orders", 1))
+//			↪️ DROP CHECK `orders_chk_1`
+func BuildImplicitCheckNameOnTable(table string, ordinal int) string {
+	return fmt.Sprintf("%s_chk_%d", table, ordinal)
+}
+
+// AddPeriodCommand is a command to add the application-time period (SQL:2011) to the table.
+//
+// Info ℹ️ available for MariaDB since 10.4.3
+//
+// Example:
+//		migrator.AddPeriodCommand{Name: "valid_period", From: "valid_from", To: "valid_to"}
+//			↪️ ADD PERIOD FOR `valid_period` (`valid_from`, `valid_to`)
+type AddPeriodCommand struct {
+	Name string
+	From string
+	To   string
+}
+
+func (c AddPeriodCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c AddPeriodCommand) ToSQLWithOptions(o Options) string {
+	if c.Name == "" || c.From == "" || c.To == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("ADD PERIOD FOR %s (%s)", o.quoteIdentifier(c.Name), o.quoteIdentifiers([]string{c.From, c.To}))
+}
+
+func (c AddPeriodCommand) Reverse() Command {
+	if c.Name == "" {
+		return nil
+	}
+
+	return DropPeriodCommand(c.Name)
+}
+
+// DropPeriodCommand is a command to remove the application-time period.
+type DropPeriodCommand string
+
+func (c DropPeriodCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c DropPeriodCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "DROP PERIOD FOR " + o.quoteIdentifier(string(c))
+}
+
+// NotNullWithCheck builds commands to safely make the column NOT NULL:
+// the check constraint validates existing rows first, then the column is modified.
+// Definition should describe the column as NOT NULL.
+//
+// Example:
+//		migrator.NotNullWithCheck("users", "email", migrator.String{Precision: 255})
+//			↪️ ADD CONSTRAINT `users_email_not_null_check` CHECK (`email` IS NOT NULL),
+//			   MODIFY `email` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL
+func NotNullWithCheck(table string, column string, definition ColumnType) TableCommands {
+	return TableCommands{
+		AddCheckCommand{
+			Name:          BuildNotNullCheckNameOnTable(table, column),
+			NotNullColumn: column,
+		},
+		ModifyColumnCommand{Name: column, Column: definition},
+	}
+}
+
+// BuildNotNullCheckNameOnTable builds a name for the NOT NULL check constraint on the table
+func BuildNotNullCheckNameOnTable(table string, column string) string {
+	return table + "_" + column + "_not_null_check"
+}
+
+// SetCompressionCommand is a command to set the page compression for InnoDB table.
+// Valid values are: zlib, lz4, none.
+//
+// Info ℹ️ available since MySQL 5.7
+type SetCompressionCommand string
+
+var compressionAlgorithms = list{"zlib", "lz4", "none"}
+
+func (c SetCompressionCommand) ToSQL() string {
+	value := strings.ToLower(string(c))
+	if !compressionAlgorithms.has(value) {
+		return ""
+	}
+
+	return fmt.Sprintf("COMPRESSION = '%s'", value)
+}
+
+func (c SetCompressionCommand) RequiredFeatures() []Feature {
+	return []Feature{FeaturePageCompression}
+}
+
+func (c SetCompressionCommand) optionOrder() int {
+	return 5
+}
+
+// SetEngineCommand is a command to change the storage engine of the table.
+type SetEngineCommand string
+
+func (c SetEngineCommand) ToSQL() string {
+	if c == "" {
+		return ""
+	}
+
+	return "ENGINE=" + string(c)
+}
+
+func (c SetEngineCommand) optionOrder() int {
+	return 0
+}
+
+// SetAutoIncrementCommand is a command to set the next auto_increment value of the table.
+// The counter could only be moved above the current maximum value of the auto_increment column,
+// InnoDB replaces lower values with the maximum plus one, so the requested value is not applied.
+type SetAutoIncrementCommand uint64
+
+func (c SetAutoIncrementCommand) ToSQL() string {
+	if c == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("AUTO_INCREMENT=%d", c)
+}
+
+// ValidateAbove checks that the value is higher than the known maximum value of the auto_increment column.
+//
+// Example:
+//		err := migrator.SetAutoIncrementCommand(100).ValidateAbove(500) // counter could not go back
+func (c SetAutoIncrementCommand) ValidateAbove(max uint64) error {
+	if uint64(c) <= max {
+		return fmt.Errorf("AUTO_INCREMENT=%d, maximum %d: %w", c, max, ErrAutoIncrementTooLow)
+	}
+
+	return nil
+}
+
+func (c SetAutoIncrementCommand) optionOrder() int {
+	return 1
+}
+
+// SetCharsetCommand is a command to change the default character set of the table.
+type SetCharsetCommand string
+
+func (c SetCharsetCommand) ToSQL() string {
+	if c == "" {
+		return ""
+	}
+
+	return "DEFAULT CHARSET=" + string(c)
+}
+
+func (c SetCharsetCommand) optionOrder() int {
+	return 2
+}
+
+// SetCollationCommand is a command to change the default collation of the table.
+type SetCollationCommand string
+
+func (c SetCollationCommand) ToSQL() string {
+	if c == "" {
+		return ""
+	}
+
+	return "COLLATE=" + string(c)
+}
+
+func (c SetCollationCommand) optionOrder() int {
+	return 3
+}
+
+// SetRowFormatCommand is a command to change the physical row format of the table.
+// Valid values are: default, dynamic, fixed, compressed, redundant, compact.
+type SetRowFormatCommand string
+
+var rowFormats = list{"DEFAULT", "DYNAMIC", "FIXED", "COMPRESSED", "REDUNDANT", "COMPACT"}
+
+func (c SetRowFormatCommand) ToSQL() string {
+	value := strings.ToUpper(string(c))
+	if !rowFormats.has(value) {
+		return ""
+	}
+
+	return "ROW_FORMAT=" + value
+}
+
+func (c SetRowFormatCommand) optionOrder() int {
+	return 4
+}
+
+// SetCommentCommand is a command to change the comment of the table.
+type SetCommentCommand string
+
+func (c SetCommentCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c SetCommentCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "COMMENT=" + o.quoteString(string(c))
+}
+
+func (c SetCommentCommand) optionOrder() int {
+	return 6
+}
+
+// SetInsertMethodCommand is a command to choose the underlying table of MERGE table for inserts.
+// Valid values are: no, first, last.
+type SetInsertMethodCommand string
+
+var insertMethods = list{"NO", "FIRST", "LAST"}
+
+func (c SetInsertMethodCommand) ToSQL() string {
+	value := strings.ToUpper(string(c))
+	if !insertMethods.has(value) {
+		return ""
+	}
+
+	return "INSERT_METHOD=" + value
+}
+
+func (c SetInsertMethodCommand) optionOrder() int {
+	return 7
+}
+
+// SetUnionCommand is a command to set the list of underlying tables of MERGE table.
+//
+// Example:
+//		migrator.SetUnionCommand{"log_2019", "log_2020"}
+//			↪️ UNION=(`log_2019`, `log_2020`)
+type SetUnionCommand []string
+
+func (c SetUnionCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c SetUnionCommand) ToSQLWithOptions(o Options) string {
+	if len(c) == 0 {
+		return ""
+	}
+
+	for _, table := range c {
+		if table == "" {
+			return ""
+		}
+	}
+
+	return "UNION=(" + o.quoteIdentifiers(c) + ")"
+}
+
+func (c SetUnionCommand) optionOrder() int {
+	return 8
+}
+
+// SetAlgorithmCommand is a command to request the algorithm used to alter the table.
+// Valid values are: default, instant, inplace, copy.
+type SetAlgorithmCommand string
+
+var algorithms = list{"DEFAULT", "INSTANT", "INPLACE", "COPY"}
+
+func (c SetAlgorithmCommand) ToSQL() string {
+	value := strings.ToUpper(string(c))
+	if !algorithms.has(value) {
+		return ""
+	}
+
+	return "ALGORITHM=" + value
+}
+
+// RenameTableCommand is a command to rename the table together with other changes in a single statement.
+// Use Schema.RenameTable to rename the table only.
+//
+// Example:
+//		migrator.TableCommands{migrator.DropColumnCommand("legacy"), migrator.RenameTableCommand("archive")}
+//			↪️ DROP COLUMN `legacy`, RENAME TO `archive`
+type RenameTableCommand string
+
+func (c RenameTableCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c RenameTableCommand) ToSQLWithOptions(o Options) string {
+	if c == "" {
+		return ""
+	}
+
+	return "RENAME TO " + o.quoteIdentifier(string(c))
+}
+
+// ReorganizePartitionCommand is a command to split or merge partitions into new partition definitions.
+//
+// Example:
+//		migrator.ReorganizePartitionCommand{Partitions: []string{"p0"}, Into: []migrator.Partition{
+//			{Name: "p0a", LessThan: "1000"},
+//			{Name: "p0b", LessThan: "2000"},
+//		}}
+//			↪️ REORGANIZE PARTITION `p0` INTO (PARTITION `p0a` VALUES LESS THAN (1000), PARTITION `p0b` VALUES LESS THAN (2000))
+type ReorganizePartitionCommand struct {
+	Partitions []string
+	Into       []Partition
+}
+
+func (c ReorganizePartitionCommand) ToSQL() string {
+	return c.ToSQLWithOptions(Options{})
+}
+
+func (c ReorganizePartitionCommand) ToSQLWithOptions(o Options) string {
+	if len(c.Partitions) == 0 || len(c.Into) == 0 {
+		return ""
+	}
+
+	into := partitions(c.Into).render(o)
+	if into == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("REORGANIZE PARTITION %s INTO (%s)", o.quoteIdentifiers(c.Partitions), into)
+}
+
+// CoalescePartitionCommand is a command to reduce the number of HASH or KEY partitions by the given number.
+type CoalescePartitionCommand int
+
+func (c CoalescePartitionCommand) ToSQL() string {
+	if c <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("COALESCE PARTITION %d", c)
+}
+
+// RemovePartitioningCommand is a command to convert partitioned table back to the regular one.
+type RemovePartitioningCommand struct{}
+
+func (c RemovePartitioningCommand) ToSQL() string {
+	return "REMOVE PARTITIONING"
+}
+
 // ADD {FULLTEXT | SPATIAL} [INDEX | KEY] [index_name] (key_part,...) [index_option] ...
-// DROP {CHECK | CONSTRAINT} symbol
-// RENAME {INDEX | KEY} old_index_name TO new_index_name
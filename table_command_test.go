@@ -1,6 +1,8 @@
 package migrator
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,538 @@ func TestTableCommands(t *testing.T) {
 		c := TableCommands{testCommand("test"), testCommand("bang")}
 		assert.Equal(t, "Do action on test, Do action on bang", c.ToSQL())
 	})
+
+	t.Run("it skips empty table options", func(t *testing.T) {
+		c := TableCommands{testCommand("test"), SetEngineCommand(""), testCommand("bang"), SetRowFormatCommand("random")}
+		assert.Equal(t, "Do action on test, Do action on bang", c.ToSQL())
+	})
+
+	t.Run("it returns empty if any other command renders empty", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "a", Column: Integer{}, After: "t.id"},
+			DropColumnCommand("b"),
+			RenameIndexCommand{Old: "PRIMARY", New: "pk"},
+		}
+		assert.Equal(t, "", c.ToSQL())
+		assert.Equal(t, "", alterTableCommand{"users", c}.ToSQL())
+
+		sql, args := c.ToSQLWithArgs()
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+}
+
+func TestTableCommandsJoin(t *testing.T) {
+	c := TableCommands{testCommand("test"), testCommand("bang")}
+
+	t.Run("it renders with default separator", func(t *testing.T) {
+		assert.Equal(t, c.ToSQL(), c.Join(DefaultCommandsSeparator))
+	})
+
+	t.Run("it renders with new line separator", func(t *testing.T) {
+		assert.Equal(t, "Do action on test,\nDo action on bang", c.Join(",\n"))
+	})
+
+	t.Run("it renders with wide separator", func(t *testing.T) {
+		assert.Equal(t, "Do action on test,  Do action on bang", c.Join(",  "))
+	})
+}
+
+func TestTableCommandsToSQLWithArgs(t *testing.T) {
+	t.Run("it renders placeholders and collects args in order", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "status", Column: String{Precision: 16, Default: "active"}},
+			DropColumnCommand("legacy"),
+			ModifyColumnCommand{Name: "kind", Column: Enum{Values: []string{"a", "b"}, Default: "a"}},
+			ChangeColumnCommand{From: "note", To: "notes", Column: Text{Nullable: Null, Default: "<nil>"}},
+		}
+		sql, args := c.ToSQLWithArgs()
+
+		assert.Equal(
+			t,
+			"ADD COLUMN `status` varchar(16) COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT ?, "+
+				"DROP COLUMN `legacy`, "+
+				"MODIFY `kind` enum('a', 'b') NOT NULL DEFAULT ?, "+
+				"CHANGE `note` `notes` text COLLATE utf8mb4_unicode_ci NULL DEFAULT ?",
+			sql,
+		)
+		assert.Equal(t, []interface{}{"active", "a", ""}, args)
+	})
+
+	t.Run("it returns empty on invalid command", func(t *testing.T) {
+		sql, args := AddColumnCommand{Column: String{Default: "active"}}.ToSQLWithArgs()
+
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+
+	t.Run("it returns empty on missing column", func(t *testing.T) {
+		sql, args := ModifyColumnCommand{Name: "test"}.ToSQLWithArgs()
+
+		assert.Equal(t, "", sql)
+		assert.Nil(t, args)
+	})
+}
+
+func TestTableCommandsChecksum(t *testing.T) {
+	t.Run("it returns sha256 of empty commands list", func(t *testing.T) {
+		assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", TableCommands{}.Checksum())
+	})
+
+	t.Run("it is stable across equivalent commands order", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}},
+			SetCommentCommand("test"),
+			SetEngineCommand("InnoDB"),
+			SetCharsetCommand("utf8mb4"),
+		}
+		shuffled := TableCommands{
+			AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}},
+			SetCharsetCommand("utf8mb4"),
+			SetCommentCommand("test"),
+			SetEngineCommand("InnoDB"),
+		}
+
+		assert.Len(t, c.Checksum(), 64)
+		assert.Equal(t, c.Checksum(), shuffled.Checksum())
+		assert.Equal(t, c.Checksum(), c.Canonical().Checksum())
+	})
+
+	t.Run("it changes with commands", func(t *testing.T) {
+		c := TableCommands{DropColumnCommand("a"), DropColumnCommand("b")}
+		reordered := TableCommands{DropColumnCommand("b"), DropColumnCommand("a")}
+
+		assert.NotEqual(t, c.Checksum(), reordered.Checksum())
+		assert.NotEqual(t, c.Checksum(), TableCommands{DropColumnCommand("a")}.Checksum())
+	})
+
+	t.Run("it hashes commands rendered with default options", func(t *testing.T) {
+		c := TableCommands{DropColumnCommand("Legacy")}
+
+		assert.Equal(t, "DROP COLUMN `legacy`", c.ToSQLWithOptions(Options{IdentifierTransform: strings.ToLower}))
+		assert.Equal(t, "a9350b6466d2d9b4a5f23d34b39a310dd2930151d726c02a9bc6edf993e7c36d", c.Checksum())
+	})
+}
+
+func TestTableCommandsSplitIndexes(t *testing.T) {
+	t.Run("it returns nothing on empty pool", func(t *testing.T) {
+		assert.Nil(t, TableCommands{}.SplitIndexes())
+	})
+
+	t.Run("it keeps metadata commands combined", func(t *testing.T) {
+		c := TableCommands{DropIndexCommand("a_idx"), RenameColumnCommand{Old: "b", New: "c"}}
+
+		assert.Equal(t, []TableCommands{c}, c.SplitIndexes())
+	})
+
+	t.Run("it splits heavy commands out", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "a", Column: testColumnType("int")},
+			DropIndexCommand("old_idx"),
+			SetCommentCommand("test"),
+			AddIndexCommand{Name: "a_idx", Columns: []string{"a"}},
+			AddUniqueIndexCommand{Key: "a_unique", Columns: []string{"a"}},
+			RenameColumnCommand{Old: "b", New: "c"},
+			DropColumnCommand("d"),
+			AddForeignCommand{Foreign{Key: "fk", Column: "a", Reference: "id", On: "b"}},
+			AddPrimaryIndexCommand("id"),
+		}
+
+		assert.Equal(t, []TableCommands{
+			{AddColumnCommand{Name: "a", Column: testColumnType("int")}},
+			{DropIndexCommand("old_idx"), SetCommentCommand("test")},
+			{AddIndexCommand{Name: "a_idx", Columns: []string{"a"}}},
+			{AddUniqueIndexCommand{Key: "a_unique", Columns: []string{"a"}}},
+			{RenameColumnCommand{Old: "b", New: "c"}},
+			{DropColumnCommand("d")},
+			{AddForeignCommand{Foreign{Key: "fk", Column: "a", Reference: "id", On: "b"}}},
+			{AddPrimaryIndexCommand("id")},
+		}, c.SplitIndexes())
+	})
+
+	t.Run("it splits data type changes out", func(t *testing.T) {
+		comment := ModifyColumnComment("b", Integer{}, "new")
+		c := TableCommands{
+			SetCommentCommand("x"),
+			ModifyColumnCommand{Name: "a", Column: Integer{Prefix: "big"}},
+			comment,
+			ChangeColumnCommand{From: "c", To: "d", Column: Integer{}},
+		}
+
+		assert.Equal(t, []TableCommands{
+			{SetCommentCommand("x")},
+			{ModifyColumnCommand{Name: "a", Column: Integer{Prefix: "big"}}},
+			{comment},
+			{ChangeColumnCommand{From: "c", To: "d", Column: Integer{}}},
+		}, c.SplitIndexes())
+	})
+
+	t.Run("it copies algorithm only into compatible pools", func(t *testing.T) {
+		c := TableCommands{
+			SetAlgorithmCommand("instant"),
+			AddIndexCommand{Name: "a_idx", Columns: []string{"a"}},
+			RenameIndexCommand{Old: "b_idx", New: "c_idx"},
+		}
+
+		assert.Equal(t, []TableCommands{
+			{AddIndexCommand{Name: "a_idx", Columns: []string{"a"}}},
+			{RenameIndexCommand{Old: "b_idx", New: "c_idx"}, SetAlgorithmCommand("instant")},
+		}, c.SplitIndexes())
+	})
+
+	t.Run("it copies algorithm into every pool", func(t *testing.T) {
+		c := TableCommands{
+			SetAlgorithmCommand("inplace"),
+			AddIndexCommand{Name: "a_idx", Columns: []string{"a"}},
+			RenameColumnCommand{Old: "b", New: "c"},
+			AddIndexCommand{Name: "c_idx", Columns: []string{"c"}},
+		}
+
+		assert.Equal(t, []TableCommands{
+			{AddIndexCommand{Name: "a_idx", Columns: []string{"a"}}, SetAlgorithmCommand("inplace")},
+			{RenameColumnCommand{Old: "b", New: "c"}, SetAlgorithmCommand("inplace")},
+			{AddIndexCommand{Name: "c_idx", Columns: []string{"c"}}, SetAlgorithmCommand("inplace")},
+		}, c.SplitIndexes())
+	})
+}
+
+func TestIndexedCollatedGeneratedColumn(t *testing.T) {
+	c := TableCommands{
+		AddColumnCommand{Name: "email_search", Column: Generated{
+			Type:       "varchar(255)",
+			Expression: "LOWER(email)",
+			Stored:     true,
+			Collate:    "utf8mb4_0900_ai_ci",
+		}},
+		AddIndexCommand{Name: "email_search_idx", Columns: []string{"email_search"}},
+	}
+
+	assert.Equal(
+		t,
+		"ADD COLUMN `email_search` varchar(255) COLLATE utf8mb4_0900_ai_ci AS (LOWER(email)) STORED NOT NULL, "+
+			"ADD KEY `email_search_idx` (`email_search`)",
+		c.ToSQL(),
+	)
+}
+
+func TestTableCommandsValidateRename(t *testing.T) {
+	t.Run("it passes standalone partition maintenance", func(t *testing.T) {
+		assert.Nil(t, TableCommands{CoalescePartitionCommand(2)}.ValidateRename())
+		assert.Nil(t, TableCommands{RemovePartitioningCommand{}}.ValidateRename())
+		assert.Nil(t, TableCommands{CoalescePartitionCommand(2), SetAlgorithmCommand("inplace")}.ValidateRename())
+	})
+
+	t.Run("it returns error on partition maintenance combined with other commands", func(t *testing.T) {
+		c := TableCommands{SetEngineCommand("MyISAM"), CoalescePartitionCommand(2), DropColumnCommand("a")}
+		err := c.ValidateRename()
+
+		assert.True(t, errors.Is(err, ErrStandalonePartitioning))
+		assert.Contains(t, err.Error(), "`COALESCE PARTITION 2` with `ENGINE=MyISAM`")
+	})
+
+	t.Run("it returns error on partitioning removal combined with other commands", func(t *testing.T) {
+		c := TableCommands{DropColumnCommand("a"), RemovePartitioningCommand{}}
+		assert.True(t, errors.Is(c.Validate(), ErrStandalonePartitioning))
+
+		c = TableCommands{SetAlgorithmCommand("copy"), RemovePartitioningCommand{}}
+		assert.True(t, errors.Is(c.ValidateRename(), ErrStandalonePartitioning))
+	})
+
+	t.Run("it returns error on reorganization combined with other commands", func(t *testing.T) {
+		c := TableCommands{
+			ReorganizePartitionCommand{Partitions: []string{"p0"}, Into: []Partition{{Name: "p0a", LessThan: "1000"}}},
+			DropColumnCommand("a"),
+		}
+		assert.True(t, errors.Is(c.ValidateRename(), ErrStandalonePartitioning))
+	})
+
+	t.Run("it passes rename combined with changes", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "a", Column: Integer{Nullable: Null}},
+			RenameTableCommand("archive"),
+			SetCommentCommand("archive"),
+		}
+		assert.Nil(t, c.ValidateRename())
+		assert.Nil(t, c.Validate())
+		assert.Equal(t, "ADD COLUMN `a` int NULL, RENAME TO `archive`, COMMENT='archive'", c.ToSQL())
+	})
+
+	t.Run("it passes rename combined with changes regardless of engine", func(t *testing.T) {
+		c := TableCommands{SetEngineCommand("MyISAM"), DropColumnCommand("a"), RenameTableCommand("archive")}
+		assert.Nil(t, c.ValidateRename())
+	})
+
+	t.Run("it returns error on rename combined with partition maintenance", func(t *testing.T) {
+		c := TableCommands{RenameTableCommand("archive"), CoalescePartitionCommand(2)}
+		err := c.Validate()
+
+		assert.True(t, errors.Is(err, ErrIncompatibleRename))
+		assert.Contains(t, err.Error(), "RENAME TO with `COALESCE PARTITION 2`")
+	})
+
+	t.Run("it returns error on rename combined with partitioning removal", func(t *testing.T) {
+		c := TableCommands{RemovePartitioningCommand{}, RenameTableCommand("archive")}
+		assert.True(t, errors.Is(c.ValidateRename(), ErrIncompatibleRename))
+	})
+}
+
+func TestRenameTableAlterCommand(t *testing.T) {
+	t.Run("it returns an empty string if name missing", func(t *testing.T) {
+		assert.Equal(t, "", RenameTableCommand("").ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		assert.Equal(t, "RENAME TO `archive`", RenameTableCommand("archive").ToSQL())
+	})
+}
+
+func TestTableCommandsWithoutExistingIndexes(t *testing.T) {
+	c := TableCommands{
+		AddIndexCommand{Name: "email_idx", Columns: []string{"email"}},
+		AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}},
+		AddUniqueIndexCommand{Key: "login_unique", Columns: []string{"login"}},
+		AddPrimaryIndexCommand("id"),
+	}
+
+	t.Run("it keeps all commands without existing indexes", func(t *testing.T) {
+		assert.Equal(t, c, c.WithoutExistingIndexes())
+	})
+
+	t.Run("it emits new indexes", func(t *testing.T) {
+		assert.Equal(t, c, c.WithoutExistingIndexes("name_idx", "test"))
+	})
+
+	t.Run("it skips indexes with colliding names", func(t *testing.T) {
+		assert.Equal(
+			t,
+			TableCommands{AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}}},
+			c.WithoutExistingIndexes("Email_IDX", "login_unique", "PRIMARY"),
+		)
+		assert.Len(t, c, 4)
+	})
+}
+
+func TestTableCommandsValidateAutoincrementKeys(t *testing.T) {
+	t.Run("it passes when primary key is not dropped", func(t *testing.T) {
+		c := TableCommands{DropColumnCommand("name")}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+
+	t.Run("it detects orphaned auto_increment column", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddPrimaryIndexCommand("uuid")}
+		err := c.ValidateAutoincrementKeys(nil, "id")
+
+		assert.True(t, errors.Is(err, ErrOrphanedAutoincrement))
+		assert.Contains(t, err.Error(), "`id`")
+	})
+
+	t.Run("it detects auto_increment column covered not as first key column", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddIndexCommand{Name: "idx", Columns: []string{"tenant_id", "id"}}}
+		assert.True(t, errors.Is(c.ValidateAutoincrementKeys(nil, "id"), ErrOrphanedAutoincrement))
+	})
+
+	t.Run("it detects auto_increment column introduced within the pool", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, ModifyColumnCommand{Name: "seq", Column: Integer{Autoincrement: true}}}
+		assert.True(t, errors.Is(c.ValidateAutoincrementKeys(nil), ErrOrphanedAutoincrement))
+	})
+
+	t.Run("it passes when new primary key covers the column", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddPrimaryIndexCommand("id")}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+
+	t.Run("it passes when unique key covers the column", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddUniqueIndexCommand{Key: "u", Parts: []KeyPart{{Column: "id"}}}}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+
+	t.Run("it passes when auto_increment is removed", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, ModifyColumnCommand{Name: "id", Column: Integer{}}}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+
+	t.Run("it passes when column is dropped", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, DropColumnCommand("id")}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+
+	t.Run("it passes when existing key covers the column", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddPrimaryIndexCommand("uuid")}
+		existing := []Key{
+			{Name: "PRIMARY", Type: "primary", Columns: []string{"id"}},
+			{Name: "id_unique", Type: "unique", Columns: []string{"ID", "tenant_id"}},
+		}
+
+		assert.Nil(t, c.ValidateAutoincrementKeys(existing, "id"))
+	})
+
+	t.Run("it detects existing key dropped within the pool", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, DropIndexCommand("ID_UNIQUE")}
+		existing := []Key{{Name: "id_unique", Type: "unique", Columns: []string{"id"}}}
+
+		assert.True(t, errors.Is(c.ValidateAutoincrementKeys(existing, "id"), ErrOrphanedAutoincrement))
+	})
+
+	t.Run("it ignores existing primary key", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}}
+		existing := []Key{{Name: "PRIMARY", Type: "primary", Columns: []string{"id"}}}
+
+		assert.True(t, errors.Is(c.ValidateAutoincrementKeys(existing, "id"), ErrOrphanedAutoincrement))
+	})
+
+	t.Run("it compares column names case-insensitively", func(t *testing.T) {
+		c := TableCommands{DropPrimaryIndexCommand{}, AddIndexCommand{Name: "idx", Columns: []string{"Seq"}}}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "seq"))
+
+		c = TableCommands{DropPrimaryIndexCommand{}, DropColumnCommand("ID")}
+		assert.Nil(t, c.ValidateAutoincrementKeys(nil, "id"))
+	})
+}
+
+func TestTableCommandsValidate(t *testing.T) {
+	t.Run("it passes valid commands", func(t *testing.T) {
+		c := TableCommands{testCommand("test"), AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it returns the first error", func(t *testing.T) {
+		c := TableCommands{
+			testCommand("test"),
+			AddColumnCommand{Name: "first", Column: Integer{}},
+			AddColumnCommand{Name: "second", Column: Integer{}},
+		}
+		assert.Contains(t, c.Validate().Error(), "`first`")
+	})
+
+	t.Run("it returns algorithm incompatibility", func(t *testing.T) {
+		c := TableCommands{SetAlgorithmCommand("inplace"), DropPrimaryIndexCommand{}}
+		assert.True(t, errors.Is(c.Validate(), ErrIncompatibleAlgorithm))
+	})
+}
+
+func TestTableCommandsValidateAlgorithm(t *testing.T) {
+	t.Run("it passes without requested algorithm", func(t *testing.T) {
+		c := TableCommands{ModifyColumnCommand{Name: "test", Column: Integer{}}}
+		assert.Nil(t, c.ValidateAlgorithm())
+	})
+
+	t.Run("it passes compatible commands", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}},
+			AddIndexCommand{Name: "test_idx", Columns: []string{"test"}},
+			RenameColumnCommand{Old: "a", New: "b"},
+			DropPrimaryIndexCommand{},
+			AddPrimaryIndexCommand("uuid"),
+			SetAlgorithmCommand("inplace"),
+		}
+		assert.Nil(t, c.ValidateAlgorithm())
+	})
+
+	t.Run("it passes any commands with copy algorithm", func(t *testing.T) {
+		c := TableCommands{SetAlgorithmCommand("copy"), ModifyColumnCommand{Name: "test", Column: Integer{}}}
+		assert.Nil(t, c.ValidateAlgorithm())
+	})
+
+	t.Run("it returns incompatible command", func(t *testing.T) {
+		c := TableCommands{
+			SetAlgorithmCommand("inplace"),
+			AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}},
+			ChangeColumnCommand{From: "a", To: "b", Column: Integer{}},
+		}
+		err := c.ValidateAlgorithm()
+
+		assert.True(t, errors.Is(err, ErrIncompatibleAlgorithm))
+		assert.Contains(t, err.Error(), "ALGORITHM=INPLACE, `CHANGE `a` `b` int NOT NULL`")
+	})
+
+	t.Run("it passes instant eligible commands", func(t *testing.T) {
+		c := TableCommands{
+			AddColumnCommand{Name: "a", Column: Integer{Nullable: Null}},
+			AddColumnCommand{Name: "b", Column: Generated{Type: "int", Expression: "a + 1"}, First: true},
+			RenameIndexCommand{Old: "from", New: "to"},
+			SetAlgorithmCommand("instant"),
+		}
+		assert.Nil(t, c.ValidateAlgorithm())
+	})
+
+	t.Run("it returns instant ineligible command", func(t *testing.T) {
+		cases := []Command{
+			AddColumnCommand{Name: "a", Column: Integer{Nullable: Null}, After: "id"},
+			AddColumnCommand{Name: "a", Column: Generated{Type: "int", Expression: "b + 1", Stored: true}},
+			AddColumnCommand{Name: "a", Column: Integer{Autoincrement: true}},
+			AddIndexCommand{Name: "idx", Columns: []string{"a"}},
+			DropPrimaryIndexCommand{},
+			ModifyColumnCommand{Name: "a", Column: Integer{}},
+		}
+
+		for _, command := range cases {
+			err := TableCommands{SetAlgorithmCommand("instant"), command}.ValidateAlgorithm()
+
+			assert.True(t, errors.Is(err, ErrIncompatibleAlgorithm))
+			assert.Contains(t, err.Error(), "ALGORITHM=INSTANT, `"+command.ToSQL()+"`")
+		}
+	})
+
+	t.Run("it returns error on foreign key addition with inplace", func(t *testing.T) {
+		c := TableCommands{
+			SetAlgorithmCommand("INPLACE"),
+			AddForeignCommand{Foreign{Key: "fk", Column: "a", Reference: "id", On: "b"}},
+		}
+		assert.True(t, errors.Is(c.ValidateAlgorithm(), ErrIncompatibleAlgorithm))
+	})
+
+	t.Run("it returns error on stored generated column and engine change with inplace", func(t *testing.T) {
+		cases := []Command{
+			AddColumnCommand{Name: "a", Column: Generated{Type: "int", Expression: "b + 1", Stored: true}},
+			SetEngineCommand("MyISAM"),
+		}
+
+		for _, command := range cases {
+			err := TableCommands{SetAlgorithmCommand("inplace"), command}.ValidateAlgorithm()
+
+			assert.True(t, errors.Is(err, ErrIncompatibleAlgorithm))
+			assert.Contains(t, err.Error(), "ALGORITHM=INPLACE, `"+command.ToSQL()+"`")
+		}
+	})
+
+	t.Run("it passes virtual generated column and innodb rebuild with inplace", func(t *testing.T) {
+		c := TableCommands{
+			SetAlgorithmCommand("inplace"),
+			AddColumnCommand{Name: "a", Column: Generated{Type: "int", Expression: "b + 1"}},
+			SetEngineCommand("InnoDB"),
+		}
+		assert.Nil(t, c.ValidateAlgorithm())
+	})
+
+	t.Run("it passes metadata only column changes", func(t *testing.T) {
+		comment := ModifyColumnComment("name", String{Precision: 255, Comment: "old"}, "new")
+		values, _ := ModifyEnumValues("status", []string{"on", "off"}, Enum{Values: []string{"on", "off", "auto"}})
+		rename := ChangeColumnCommand{From: "a", To: "b", Column: Integer{}, Old: Integer{}}
+
+		for _, algorithm := range []string{"inplace", "instant"} {
+			c := TableCommands{SetAlgorithmCommand(algorithm), comment, values, rename}
+			assert.Nil(t, c.ValidateAlgorithm())
+			assert.Nil(t, c.Validate())
+		}
+	})
+
+	t.Run("it returns error on data type changes", func(t *testing.T) {
+		widening, _ := ModifyColumnType("views", Integer{}, Integer{Prefix: "big"})
+		set, _ := ModifyEnumValues("flags", []string{"a", "b", "c", "d", "e", "f", "g", "h"}, Enum{Values: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}, Multiple: true})
+		cases := []Command{
+			widening,
+			set,
+			ModifyColumnCommand{Name: "a", Column: Integer{Comment: "new"}},
+			ChangeColumnCommand{From: "a", To: "b", Column: Integer{Prefix: "big"}, Old: Integer{}},
+		}
+
+		for _, command := range cases {
+			for _, algorithm := range []string{"inplace", "instant"} {
+				err := TableCommands{SetAlgorithmCommand(algorithm), command}.ValidateAlgorithm()
+				assert.True(t, errors.Is(err, ErrIncompatibleAlgorithm))
+			}
+		}
+	})
 }
 
 func TestAddColumnCommand(t *testing.T) {
@@ -46,7 +580,25 @@ func TestAddColumnCommand(t *testing.T) {
 
 	t.Run("it returns row with after column", func(t *testing.T) {
 		c := AddColumnCommand{Name: "test_id", Column: testColumnType("definition"), After: "id"}
-		assert.Equal(t, "ADD COLUMN `test_id` definition AFTER id", c.ToSQL())
+		assert.Equal(t, "ADD COLUMN `test_id` definition AFTER `id`", c.ToSQL())
+	})
+
+	t.Run("it quotes reserved and numeric after column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test_id", Column: testColumnType("definition"), After: "order"}
+		assert.Equal(t, "ADD COLUMN `test_id` definition AFTER `order`", c.ToSQL())
+
+		c = AddColumnCommand{Name: "test_id", Column: testColumnType("definition"), After: "123"}
+		assert.Equal(t, "ADD COLUMN `test_id` definition AFTER `123`", c.ToSQL())
+	})
+
+	t.Run("it returns spatial column with srid placed first", func(t *testing.T) {
+		c := AddColumnCommand{Name: "location", Column: Spatial{Type: "point", SRID: "4326"}, First: true}
+		assert.Equal(t, "ADD COLUMN `location` point NOT NULL SRID 4326 FIRST", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string if after column is qualified", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test_id", Column: testColumnType("definition"), After: "tests.id"}
+		assert.Equal(t, "", c.ToSQL())
 	})
 
 	t.Run("it returns row with first flag", func(t *testing.T) {
@@ -55,6 +607,116 @@ func TestAddColumnCommand(t *testing.T) {
 	})
 }
 
+func TestAddColumnCommandValidate(t *testing.T) {
+	t.Run("it warns on NOT NULL column without default", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: String{Precision: 255}}
+		err := c.Validate()
+
+		assert.True(t, errors.Is(err, ErrNotNullWithoutDefault))
+		assert.Contains(t, err.Error(), "`test`")
+	})
+
+	t.Run("it passes with nullable column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: String{Nullable: Null}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it passes with unspecified nullability", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: String{Nullable: NullUnspecified}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it passes with default value", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: Integer{Default: "0"}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it passes with auto_increment column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "id", Column: Integer{Autoincrement: true}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it passes with generated column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "total", Column: Generated{Type: "int", Expression: "a + b"}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it rejects qualified after column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}, After: "tests.id"}
+		err := c.Validate()
+
+		assert.True(t, errors.Is(err, ErrInvalidColumnPosition))
+		assert.Contains(t, err.Error(), `"tests.id"`)
+	})
+
+	t.Run("it rejects after column with invalid characters", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}, After: "id`; DROP TABLE x"}
+		assert.True(t, errors.Is(c.Validate(), ErrInvalidColumnPosition))
+	})
+
+	t.Run("it passes with simple after column", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: Integer{Nullable: Null}, After: "created_at"}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it passes with expression default", func(t *testing.T) {
+		c := AddColumnCommand{Name: "code", Column: String{Default: "(CONCAT('x', UUID()))"}}
+		assert.Nil(t, c.Validate())
+	})
+
+	t.Run("it returns error on empty expression default", func(t *testing.T) {
+		c := AddColumnCommand{Name: "code", Column: String{Default: "()"}}
+		err := c.Validate()
+
+		assert.True(t, errors.Is(err, ErrEmptyDefaultExpression))
+		assert.Contains(t, err.Error(), "`code`")
+	})
+
+	t.Run("it returns error on empty expression default on modify and change", func(t *testing.T) {
+		assert.True(t, errors.Is(ModifyColumnCommand{Name: "code", Column: Binary{Default: "( )"}}.Validate(), ErrEmptyDefaultExpression))
+		assert.True(t, errors.Is(ChangeColumnCommand{From: "a", To: "b", Column: JSON{Default: "()"}}.Validate(), ErrEmptyDefaultExpression))
+		assert.Nil(t, ModifyColumnCommand{Name: "code", Column: Binary{Default: "(UUID_TO_BIN(UUID()))"}}.Validate())
+	})
+
+	t.Run("it returns error on text character set mismatch", func(t *testing.T) {
+		c := AddColumnCommand{Name: "body", Column: Text{Nullable: Null, Charset: "latin1", Collate: "utf8mb4_bin"}}
+		err := c.Validate()
+
+		assert.True(t, errors.Is(err, ErrCollationMismatch))
+		assert.Contains(t, err.Error(), "`body`")
+		assert.True(t, errors.Is(ModifyColumnCommand{Name: "body", Column: Text{Blob: true, Charset: "utf8mb4"}}.Validate(), ErrBinaryCharset))
+	})
+
+	t.Run("it passes with unknown column type", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: testColumnType("definition")}
+		assert.Nil(t, c.Validate())
+	})
+}
+
+func TestAddTimestamps(t *testing.T) {
+	c := AddTimestamps()
+
+	assert.Equal(t, TableCommands{
+		AddColumnCommand{Name: "created_at", Column: Timable{Type: "timestamp", Precision: 6, Default: "CURRENT_TIMESTAMP(6)"}},
+		AddColumnCommand{Name: "updated_at", Column: Timable{Type: "timestamp", Precision: 6, Default: "CURRENT_TIMESTAMP(6)", OnUpdate: "CURRENT_TIMESTAMP(6)"}},
+	}, c)
+	assert.Equal(
+		t,
+		"ADD COLUMN `created_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6), "+
+			"ADD COLUMN `updated_at` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6)",
+		c.ToSQL(),
+	)
+	assert.Nil(t, c.Validate())
+}
+
+func TestAddSoftDeletes(t *testing.T) {
+	c := AddSoftDeletes()
+
+	assert.Equal(t, TableCommands{AddColumnCommand{Name: "deleted_at", Column: Timable{Type: "timestamp", Precision: 6, Nullable: Null}}}, c)
+	assert.Equal(t, "ADD COLUMN `deleted_at` timestamp(6) NULL", c.ToSQL())
+	assert.Nil(t, c.Validate())
+}
+
 func TestRenameColumnCommand(t *testing.T) {
 	t.Run("it returns an empty string if old name missing", func(t *testing.T) {
 		c := RenameColumnCommand{New: "test"}
@@ -94,6 +756,70 @@ func TestModifyColumnCommand(t *testing.T) {
 	})
 }
 
+func TestModifyColumnComment(t *testing.T) {
+	t.Run("it returns an empty string for custom column type", func(t *testing.T) {
+		c := ModifyColumnComment("test", testColumnType("test"), "new")
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it swaps the comment keeping the definition", func(t *testing.T) {
+		column := String{Precision: 255, Nullable: Null, Default: "guest", Comment: "old"}
+		c := ModifyColumnComment("name", column, "new")
+
+		assert.Equal(t, ModifyColumnCommand{Name: "name", Column: String{Precision: 255, Nullable: Null, Default: "guest", Comment: "new"}, Old: column}, c)
+		assert.Equal(t, "MODIFY `name` varchar(255) COLLATE utf8mb4_unicode_ci NULL DEFAULT 'guest' COMMENT 'new'", c.ToSQL())
+		assert.Equal(t, "old", column.Comment)
+	})
+
+	t.Run("it removes the comment", func(t *testing.T) {
+		c := ModifyColumnComment("id", Integer{Unsigned: true, Autoincrement: true, Comment: "old"}, "")
+		assert.Equal(t, "MODIFY `id` int unsigned NOT NULL AUTO_INCREMENT", c.ToSQL())
+	})
+}
+
+func TestModifySpatialSRID(t *testing.T) {
+	t.Run("it returns an empty string on incomplete input", func(t *testing.T) {
+		assert.Equal(t, "", ModifySpatialSRID("", Spatial{Type: "point"}, "4326").ToSQL())
+		assert.Equal(t, "", ModifySpatialSRID("location", Spatial{Type: "point"}, "wgs84").ToSQL())
+	})
+
+	t.Run("it sets the SRID keeping the definition", func(t *testing.T) {
+		c := ModifySpatialSRID("location", Spatial{Type: "point", Nullable: Null, Comment: "gps"}, "4326")
+		assert.Equal(t, "MODIFY `location` point NULL SRID 4326 COMMENT 'gps'", c.ToSQL())
+	})
+
+	t.Run("it clears the SRID", func(t *testing.T) {
+		c := ModifySpatialSRID("location", Spatial{Type: "point", SRID: "4326"}, "")
+		assert.Equal(t, "MODIFY `location` point NOT NULL", c.ToSQL())
+	})
+}
+
+func TestModifyEnumValues(t *testing.T) {
+	t.Run("it modifies column on appended values", func(t *testing.T) {
+		c, err := ModifyEnumValues("status", []string{"on", "off"}, Enum{Values: []string{"on", "off", "auto"}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, ModifyColumnCommand{Name: "status", Column: Enum{Values: []string{"on", "off", "auto"}}, Old: Enum{Values: []string{"on", "off"}}}, c)
+		assert.Equal(t, "MODIFY `status` enum('on', 'off', 'auto') NOT NULL", c.ToSQL())
+	})
+
+	t.Run("it warns on reordered values", func(t *testing.T) {
+		c, err := ModifyEnumValues("status", []string{"on", "off"}, Enum{Values: []string{"off", "on"}})
+
+		assert.True(t, errors.Is(err, ErrEnumReorder))
+		assert.Contains(t, err.Error(), "column `status` value 'on'")
+		assert.Equal(t, "MODIFY `status` enum('off', 'on') NOT NULL", c.ToSQL())
+	})
+
+	t.Run("it warns on inserted and removed values", func(t *testing.T) {
+		_, err := ModifyEnumValues("status", []string{"on", "off"}, Enum{Values: []string{"on", "auto", "off"}})
+		assert.True(t, errors.Is(err, ErrEnumReorder))
+
+		_, err = ModifyEnumValues("status", []string{"on", "off"}, Enum{Values: []string{"on"}})
+		assert.True(t, errors.Is(err, ErrEnumReorder))
+	})
+}
+
 func TestChangeColumnCommand(t *testing.T) {
 	t.Run("it returns an empty string if column definition missing", func(t *testing.T) {
 		c := ChangeColumnCommand{From: "tests", To: "something"}
@@ -148,6 +874,47 @@ func TestAddIndexCommand(t *testing.T) {
 		c := AddIndexCommand{Name: "test_idx", Columns: []string{"test"}}
 		assert.Equal(t, "ADD KEY `test_idx` (`test`)", c.ToSQL())
 	})
+
+	t.Run("it returns a row with multi-valued json index", func(t *testing.T) {
+		c := AddIndexCommand{Name: "tags_idx", Columns: []string{"user_id"}, Parts: []KeyPart{
+			{Expression: "CAST(data->'$.tags' AS CHAR(64) ARRAY)", MultiValued: true},
+		}}
+		assert.Equal(t, "ADD KEY `tags_idx` (`user_id`, (CAST(data->'$.tags' AS CHAR(64) ARRAY)))", c.ToSQL())
+	})
+
+	t.Run("it returns a row with explicit ascending order", func(t *testing.T) {
+		c := AddIndexCommand{Name: "idx", Columns: []string{"a"}, Parts: []KeyPart{
+			{Column: "b", Direction: "desc"},
+			{Column: "c", Length: 10, Direction: "asc"},
+			{Expression: "LOWER(email)"},
+		}, ExplicitAsc: true}
+		assert.Equal(t, "ADD KEY `idx` (`a` ASC, `b` DESC, `c`(10) ASC, (LOWER(email)) ASC)", c.ToSQL())
+	})
+
+	t.Run("it omits ascending order by default", func(t *testing.T) {
+		c := AddIndexCommand{Name: "idx", Parts: []KeyPart{{Column: "a", Direction: "asc"}}}
+		assert.Equal(t, "ADD KEY `idx` (`a`)", c.ToSQL())
+	})
+
+	t.Run("it returns a row with functional and prefixed key parts", func(t *testing.T) {
+		c := AddIndexCommand{Name: "idx", Parts: []KeyPart{
+			{Expression: "LOWER(email)"},
+			{Column: "name", Length: 20},
+		}}
+		assert.Equal(t, "ADD KEY `idx` ((LOWER(email)), `name`(20))", c.ToSQL())
+		assert.Equal(t, []Feature{FeatureFunctionalKeyParts}, c.RequiredFeatures())
+		assert.Equal(t, c.ToSQL(), Index("idx").Expression("LOWER(email)").Column("name").Prefix(20).Command().ToSQL())
+	})
+
+	t.Run("it returns a row with invisible index", func(t *testing.T) {
+		c := AddIndexCommand{Name: "test_idx", Columns: []string{"test"}, Invisible: true}
+		assert.Equal(t, "ADD KEY `test_idx` (`test`) INVISIBLE", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string on invalid key part", func(t *testing.T) {
+		c := AddIndexCommand{Name: "tags_idx", Parts: []KeyPart{{MultiValued: true}}}
+		assert.Equal(t, "", c.ToSQL())
+	})
 }
 
 func TestDropIndexCommand(t *testing.T) {
@@ -162,6 +929,45 @@ func TestDropIndexCommand(t *testing.T) {
 	})
 }
 
+func TestRenameIndexCommand(t *testing.T) {
+	t.Run("it returns an empty string if old name missing", func(t *testing.T) {
+		c := RenameIndexCommand{New: "test"}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string if new name missing", func(t *testing.T) {
+		c := RenameIndexCommand{Old: "test"}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		c := RenameIndexCommand{Old: "from", New: "to"}
+		assert.Equal(t, "RENAME INDEX `from` TO `to`", c.ToSQL())
+	})
+
+	t.Run("it returns a row with key alias", func(t *testing.T) {
+		c := RenameIndexCommand{Old: "from", New: "to", Key: true}
+		assert.Equal(t, "RENAME KEY `from` TO `to`", c.ToSQL())
+	})
+
+	t.Run("it returns an error on primary key rename", func(t *testing.T) {
+		c := RenameIndexCommand{Old: "PRIMARY", New: "pk"}
+		assert.Equal(t, ErrRenamePrimaryKey, c.Validate())
+		assert.Equal(t, ErrRenamePrimaryKey, Validate(c))
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an error on rename to primary", func(t *testing.T) {
+		c := RenameIndexCommand{Old: "pk", New: "primary"}
+		assert.Equal(t, ErrRenamePrimaryKey, c.Validate())
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it passes validation on regular rename", func(t *testing.T) {
+		assert.Nil(t, RenameIndexCommand{Old: "from", New: "to"}.Validate())
+	})
+}
+
 func TestAddForeignCommand(t *testing.T) {
 	t.Run("it returns an empty string on missing foreign key", func(t *testing.T) {
 		c := AddForeignCommand{}
@@ -174,6 +980,18 @@ func TestAddForeignCommand(t *testing.T) {
 	})
 }
 
+func TestAddForeignCommandValidate(t *testing.T) {
+	t.Run("it returns error on missing referenced columns", func(t *testing.T) {
+		c := AddForeignCommand{Foreign{Key: "idx_foreign", Column: "test_id", On: "tests"}}
+		assert.True(t, errors.Is(c.Validate(), ErrMissingReferencedColumns))
+	})
+
+	t.Run("it passes valid foreign key", func(t *testing.T) {
+		c := AddForeignCommand{Foreign{Key: "idx_foreign", Column: "test_id", Reference: "id", On: "tests"}}
+		assert.Nil(t, c.Validate())
+	})
+}
+
 func TestDropForeignCommand(t *testing.T) {
 	t.Run("it returns an empty string if index name missing", func(t *testing.T) {
 		c := DropForeignCommand("")
@@ -186,6 +1004,25 @@ func TestDropForeignCommand(t *testing.T) {
 	})
 }
 
+func TestDropForeignWithIndex(t *testing.T) {
+	t.Run("it returns an empty string if foreign key name is missing", func(t *testing.T) {
+		c := DropForeignWithIndex("", "idx")
+		assert.Len(t, c, 0)
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it drops index named after foreign key", func(t *testing.T) {
+		c := DropForeignWithIndex("fk", "")
+		assert.Equal(t, TableCommands{DropForeignCommand("fk"), DropIndexCommand("fk")}, c)
+		assert.Equal(t, "DROP FOREIGN KEY `fk`, DROP KEY `fk`", c.ToSQL())
+	})
+
+	t.Run("it drops explicitly named index", func(t *testing.T) {
+		c := DropForeignWithIndex("fk", "idx")
+		assert.Equal(t, "DROP FOREIGN KEY `fk`, DROP KEY `idx`", c.ToSQL())
+	})
+}
+
 func TestAddUniqueIndexCommand(t *testing.T) {
 	t.Run("it returns an empty string if index name missing", func(t *testing.T) {
 		c := AddUniqueIndexCommand{Columns: []string{"test"}}
@@ -201,6 +1038,29 @@ func TestAddUniqueIndexCommand(t *testing.T) {
 		c := AddUniqueIndexCommand{Key: "test_idx", Columns: []string{"test"}}
 		assert.Equal(t, "ADD UNIQUE KEY `test_idx` (`test`)", c.ToSQL())
 	})
+
+	t.Run("it returns a row with mixed composite key parts", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "u", Parts: []KeyPart{
+			{Column: "a", Length: 20, Direction: "desc"},
+			{Column: "b"},
+		}}
+		assert.Equal(t, "ADD UNIQUE KEY `u` (`a`(20) DESC, `b`)", c.ToSQL())
+	})
+
+	t.Run("it returns a row with invisible index", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "u", Columns: []string{"a"}, Invisible: true}
+		assert.Equal(t, "ADD UNIQUE KEY `u` (`a`) INVISIBLE", c.ToSQL())
+	})
+
+	t.Run("it returns a row with columns followed by key parts", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "u", Columns: []string{"a"}, Parts: []KeyPart{{Column: "b", Length: 10}}}
+		assert.Equal(t, "ADD UNIQUE KEY `u` (`a`, `b`(10))", c.ToSQL())
+	})
+
+	t.Run("it returns a row with explicit ascending order", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "idx", Columns: []string{"a", "b"}, ExplicitAsc: true}
+		assert.Equal(t, "ADD UNIQUE KEY `idx` (`a` ASC, `b` ASC)", c.ToSQL())
+	})
 }
 
 func TestAddPrimaryIndexCommand(t *testing.T) {
@@ -219,3 +1079,383 @@ func TestDropPrimaryIndexCommand(t *testing.T) {
 	c := DropPrimaryIndexCommand{}
 	assert.Equal(t, "DROP PRIMARY KEY", c.ToSQL())
 }
+
+func TestAddCheckCommand(t *testing.T) {
+	t.Run("it returns an empty string if expression missing", func(t *testing.T) {
+		c := AddCheckCommand{Name: "test"}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a row without name", func(t *testing.T) {
+		c := AddCheckCommand{Expression: "price > 0"}
+		assert.Equal(t, "ADD CHECK (price > 0)", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		c := AddCheckCommand{Name: "price_check", Expression: "price > 0"}
+		assert.Equal(t, "ADD CONSTRAINT `price_check` CHECK (price > 0)", c.ToSQL())
+	})
+
+	t.Run("it returns a not enforced row", func(t *testing.T) {
+		c := AddCheckCommand{Name: "price_check", Expression: "price > 0", NotEnforced: true}
+		assert.Equal(t, "ADD CONSTRAINT `price_check` CHECK (price > 0) NOT ENFORCED", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string if expression is blank", func(t *testing.T) {
+		assert.Equal(t, "", AddCheckCommand{Name: "test", Expression: "  "}.ToSQL())
+		assert.Equal(t, "", AddCheckCommand{Name: "test", Expression: "( )"}.ToSQL())
+	})
+
+	t.Run("it wraps multi-column expression", func(t *testing.T) {
+		c := AddCheckCommand{Name: "test", Expression: "start_date < end_date AND quantity >= 0"}
+		assert.Equal(t, "ADD CONSTRAINT `test` CHECK (start_date < end_date AND quantity >= 0)", c.ToSQL())
+	})
+
+	t.Run("it does not wrap parenthesized expression twice", func(t *testing.T) {
+		c := AddCheckCommand{Name: "test", Expression: " (start_date < end_date AND quantity >= 0) "}
+		assert.Equal(t, "ADD CONSTRAINT `test` CHECK (start_date < end_date AND quantity >= 0)", c.ToSQL())
+	})
+
+	t.Run("it wraps expression with separate parenthesized groups", func(t *testing.T) {
+		c := AddCheckCommand{Name: "test", Expression: "(a > 0) AND (b > 0)"}
+		assert.Equal(t, "ADD CONSTRAINT `test` CHECK ((a > 0) AND (b > 0))", c.ToSQL())
+	})
+
+	t.Run("it validates expression is not empty", func(t *testing.T) {
+		assert.Equal(t, ErrEmptyCheckExpression, AddCheckCommand{Name: "test", Expression: "()"}.Validate())
+		assert.Nil(t, AddCheckCommand{Name: "test", Expression: "a < b"}.Validate())
+	})
+}
+
+func TestDropCheckCommand(t *testing.T) {
+	t.Run("it returns an empty string if name missing", func(t *testing.T) {
+		c := DropCheckCommand("")
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		c := DropCheckCommand("price_check")
+		assert.Equal(t, "DROP CHECK `price_check`", c.ToSQL())
+	})
+}
+
+func TestBuildImplicitCheckNameOnTable(t *testing.T) {
+	assert.Equal(t, "orders_chk_1", BuildImplicitCheckNameOnTable("orders", 1))
+	assert.Equal(t, "orders_chk_12", BuildImplicitCheckNameOnTable("orders", 12))
+	assert.Equal(t, "DROP CHECK `orders_chk_2`", DropCheckCommand(BuildImplicitCheckNameOnTable("orders", 2)).ToSQL())
+}
+
+func TestAddPeriodCommand(t *testing.T) {
+	t.Run("it returns an empty string on incomplete input", func(t *testing.T) {
+		assert.Equal(t, "", AddPeriodCommand{From: "valid_from", To: "valid_to"}.ToSQL())
+		assert.Equal(t, "", AddPeriodCommand{Name: "valid_period", To: "valid_to"}.ToSQL())
+		assert.Equal(t, "", AddPeriodCommand{Name: "valid_period", From: "valid_from"}.ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		c := AddPeriodCommand{Name: "valid_period", From: "valid_from", To: "valid_to"}
+		assert.Equal(t, "ADD PERIOD FOR `valid_period` (`valid_from`, `valid_to`)", c.ToSQL())
+	})
+
+	t.Run("it reverses to period drop", func(t *testing.T) {
+		c := AddPeriodCommand{Name: "valid_period", From: "valid_from", To: "valid_to"}
+		assert.Equal(t, DropPeriodCommand("valid_period"), c.Reverse())
+	})
+}
+
+func TestDropPeriodCommand(t *testing.T) {
+	t.Run("it returns an empty string if name missing", func(t *testing.T) {
+		assert.Equal(t, "", DropPeriodCommand("").ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		assert.Equal(t, "DROP PERIOD FOR `valid_period`", DropPeriodCommand("valid_period").ToSQL())
+	})
+}
+
+func TestNotNullWithCheck(t *testing.T) {
+	c := NotNullWithCheck("users", "email", String{Precision: 255})
+
+	assert.Len(t, c, 2)
+	assert.Equal(t, AddCheckCommand{Name: "users_email_not_null_check", NotNullColumn: "email"}, c[0])
+	assert.Equal(t, ModifyColumnCommand{Name: "email", Column: String{Precision: 255}}, c[1])
+	assert.Equal(
+		t,
+		"ADD CONSTRAINT `users_email_not_null_check` CHECK (`email` IS NOT NULL), "+
+			"MODIFY `email` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL",
+		c.ToSQL(),
+	)
+	assert.Equal(
+		t,
+		"ADD CONSTRAINT `USERS_EMAIL_NOT_NULL_CHECK` CHECK (`EMAIL` IS NOT NULL), "+
+			"MODIFY `EMAIL` varchar(255) COLLATE utf8mb4_unicode_ci NOT NULL",
+		c.ToSQLWithOptions(Options{IdentifierTransform: strings.ToUpper}),
+	)
+}
+
+func TestSetCompressionCommand(t *testing.T) {
+	t.Run("it returns an empty string if compression missing", func(t *testing.T) {
+		c := SetCompressionCommand("")
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string on invalid compression", func(t *testing.T) {
+		c := SetCompressionCommand("gzip")
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row for zlib", func(t *testing.T) {
+		c := SetCompressionCommand("zlib")
+		assert.Equal(t, "COMPRESSION = 'zlib'", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row for lz4", func(t *testing.T) {
+		c := SetCompressionCommand("LZ4")
+		assert.Equal(t, "COMPRESSION = 'lz4'", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row for none", func(t *testing.T) {
+		c := SetCompressionCommand("none")
+		assert.Equal(t, "COMPRESSION = 'none'", c.ToSQL())
+	})
+}
+
+func TestCommandsWithCopies(t *testing.T) {
+	t.Run("it copies add column command", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: testColumnType("definition")}
+		copied := c.WithName("renamed").WithAfter("id")
+
+		assert.Equal(t, "ADD COLUMN `test` definition", c.ToSQL())
+		assert.Equal(t, "ADD COLUMN `renamed` definition AFTER `id`", copied.ToSQL())
+
+		copied = c.WithColumn(testColumnType("another")).WithFirst(true)
+		assert.Equal(t, "ADD COLUMN `test` definition", c.ToSQL())
+		assert.Equal(t, "ADD COLUMN `test` another FIRST", copied.ToSQL())
+	})
+
+	t.Run("it copies rename column command", func(t *testing.T) {
+		c := RenameColumnCommand{Old: "from", New: "to"}
+		copied := c.WithOld("a").WithNew("b")
+
+		assert.Equal(t, RenameColumnCommand{Old: "from", New: "to"}, c)
+		assert.Equal(t, RenameColumnCommand{Old: "a", New: "b"}, copied)
+	})
+
+	t.Run("it copies modify column command", func(t *testing.T) {
+		c := ModifyColumnCommand{Name: "test", Column: testColumnType("definition")}
+		copied := c.WithName("renamed").WithColumn(testColumnType("another"))
+
+		assert.Equal(t, "MODIFY `test` definition", c.ToSQL())
+		assert.Equal(t, "MODIFY `renamed` another", copied.ToSQL())
+	})
+
+	t.Run("it copies change column command", func(t *testing.T) {
+		c := ChangeColumnCommand{From: "a", To: "b", Column: testColumnType("definition")}
+		copied := c.WithFrom("c").WithTo("d").WithColumn(testColumnType("another"))
+
+		assert.Equal(t, "CHANGE `a` `b` definition", c.ToSQL())
+		assert.Equal(t, "CHANGE `c` `d` another", copied.ToSQL())
+	})
+
+	t.Run("it copies add index command without sharing columns", func(t *testing.T) {
+		columns := []string{"a", "b"}
+		c := AddIndexCommand{Name: "idx", Columns: columns}
+		copied := c.WithName("new_idx").WithColumns(columns...)
+		copied.Columns[0] = "z"
+
+		assert.Equal(t, "ADD KEY `idx` (`a`, `b`)", c.ToSQL())
+		assert.Equal(t, "ADD KEY `new_idx` (`z`, `b`)", copied.ToSQL())
+
+		copied = c.WithParts(KeyPart{Expression: "LOWER(a)"})
+		assert.Equal(t, "ADD KEY `idx` (`a`, `b`)", c.ToSQL())
+		assert.Equal(t, "ADD KEY `idx` (`a`, `b`, (LOWER(a)))", copied.ToSQL())
+	})
+
+	t.Run("it copies add foreign command", func(t *testing.T) {
+		c := AddForeignCommand{Foreign{Key: "fk", Column: "test_id", Reference: "id", On: "tests"}}
+		copied := c.WithForeign(Foreign{Key: "fk2", Column: "random_id", Reference: "id", On: "randoms"})
+
+		assert.Equal(t, "ADD CONSTRAINT `fk` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)", c.ToSQL())
+		assert.Equal(t, "ADD CONSTRAINT `fk2` FOREIGN KEY (`random_id`) REFERENCES `randoms` (`id`)", copied.ToSQL())
+	})
+
+	t.Run("it copies add unique index command", func(t *testing.T) {
+		c := AddUniqueIndexCommand{Key: "u", Columns: []string{"a"}}
+		copied := c.WithKey("u2").WithColumns("b", "c")
+
+		assert.Equal(t, "ADD UNIQUE KEY `u` (`a`)", c.ToSQL())
+		assert.Equal(t, "ADD UNIQUE KEY `u2` (`b`, `c`)", copied.ToSQL())
+	})
+}
+
+func TestReorganizePartitionCommand(t *testing.T) {
+	t.Run("it returns an empty string if partitions missing", func(t *testing.T) {
+		c := ReorganizePartitionCommand{Into: []Partition{{Name: "p0a"}}}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string if new definitions missing", func(t *testing.T) {
+		c := ReorganizePartitionCommand{Partitions: []string{"p0"}}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string on incomplete definition", func(t *testing.T) {
+		c := ReorganizePartitionCommand{Partitions: []string{"p0"}, Into: []Partition{{Name: "p0a"}, {LessThan: "200"}}}
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a row to reorganize into two partitions", func(t *testing.T) {
+		c := ReorganizePartitionCommand{Partitions: []string{"p0"}, Into: []Partition{
+			{Name: "p0a", LessThan: "100"},
+			{Name: "p0b", LessThan: "200"},
+		}}
+		assert.Equal(t, "REORGANIZE PARTITION `p0` INTO (PARTITION `p0a` VALUES LESS THAN (100), PARTITION `p0b` VALUES LESS THAN (200))", c.ToSQL())
+	})
+
+	t.Run("it returns a row to merge partitions", func(t *testing.T) {
+		c := ReorganizePartitionCommand{Partitions: []string{"p0", "p1"}, Into: []Partition{{Name: "p01", LessThan: "maxvalue"}}}
+		assert.Equal(t, "REORGANIZE PARTITION `p0`, `p1` INTO (PARTITION `p01` VALUES LESS THAN MAXVALUE)", c.ToSQL())
+	})
+}
+
+func TestCoalescePartitionCommand(t *testing.T) {
+	t.Run("it returns an empty string on zero", func(t *testing.T) {
+		c := CoalescePartitionCommand(0)
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns an empty string on negative number", func(t *testing.T) {
+		c := CoalescePartitionCommand(-2)
+		assert.Equal(t, "", c.ToSQL())
+	})
+
+	t.Run("it returns a proper row", func(t *testing.T) {
+		c := CoalescePartitionCommand(4)
+		assert.Equal(t, "COALESCE PARTITION 4", c.ToSQL())
+	})
+}
+
+func TestRemovePartitioningCommand(t *testing.T) {
+	c := RemovePartitioningCommand{}
+	assert.Equal(t, "REMOVE PARTITIONING", c.ToSQL())
+}
+
+func TestTableOptionCommands(t *testing.T) {
+	t.Run("it returns empty strings on missing values", func(t *testing.T) {
+		assert.Equal(t, "", SetEngineCommand("").ToSQL())
+		assert.Equal(t, "", SetAutoIncrementCommand(0).ToSQL())
+		assert.Equal(t, "", SetCharsetCommand("").ToSQL())
+		assert.Equal(t, "", SetCollationCommand("").ToSQL())
+		assert.Equal(t, "", SetRowFormatCommand("").ToSQL())
+		assert.Equal(t, "", SetCommentCommand("").ToSQL())
+		assert.Equal(t, "", SetAlgorithmCommand("").ToSQL())
+	})
+
+	t.Run("it returns an empty string on invalid row format", func(t *testing.T) {
+		assert.Equal(t, "", SetRowFormatCommand("random").ToSQL())
+	})
+
+	t.Run("it returns an empty string on invalid algorithm", func(t *testing.T) {
+		assert.Equal(t, "", SetAlgorithmCommand("random").ToSQL())
+	})
+
+	t.Run("it returns an empty string on invalid insert method", func(t *testing.T) {
+		assert.Equal(t, "", SetInsertMethodCommand("").ToSQL())
+		assert.Equal(t, "", SetInsertMethodCommand("middle").ToSQL())
+	})
+
+	t.Run("it returns an empty string on incomplete union", func(t *testing.T) {
+		assert.Equal(t, "", SetUnionCommand{}.ToSQL())
+		assert.Equal(t, "", SetUnionCommand{"t1", ""}.ToSQL())
+	})
+
+	t.Run("it returns proper rows", func(t *testing.T) {
+		assert.Equal(t, "ENGINE=InnoDB", SetEngineCommand("InnoDB").ToSQL())
+		assert.Equal(t, "AUTO_INCREMENT=1000", SetAutoIncrementCommand(1000).ToSQL())
+		assert.Equal(t, "DEFAULT CHARSET=utf8mb4", SetCharsetCommand("utf8mb4").ToSQL())
+		assert.Equal(t, "COLLATE=utf8mb4_unicode_ci", SetCollationCommand("utf8mb4_unicode_ci").ToSQL())
+		assert.Equal(t, "ROW_FORMAT=DYNAMIC", SetRowFormatCommand("dynamic").ToSQL())
+		assert.Equal(t, "COMMENT='users'", SetCommentCommand("users").ToSQL())
+		assert.Equal(t, "ALGORITHM=INPLACE", SetAlgorithmCommand("inplace").ToSQL())
+		assert.Equal(t, "INSERT_METHOD=LAST", SetInsertMethodCommand("last").ToSQL())
+		assert.Equal(t, "UNION=(`t1`, `t2`)", SetUnionCommand{"t1", "t2"}.ToSQL())
+	})
+}
+
+func TestSetAutoIncrementCommandValidateAbove(t *testing.T) {
+	t.Run("it renders the statement", func(t *testing.T) {
+		assert.Equal(t, "AUTO_INCREMENT=1000", SetAutoIncrementCommand(1000).ToSQL())
+	})
+
+	t.Run("it passes value above maximum", func(t *testing.T) {
+		assert.Nil(t, SetAutoIncrementCommand(1000).ValidateAbove(999))
+		assert.Nil(t, SetAutoIncrementCommand(1).ValidateAbove(0))
+	})
+
+	t.Run("it returns error on value not above maximum", func(t *testing.T) {
+		err := SetAutoIncrementCommand(100).ValidateAbove(500)
+		assert.True(t, errors.Is(err, ErrAutoIncrementTooLow))
+		assert.Contains(t, err.Error(), "AUTO_INCREMENT=100, maximum 500")
+		assert.True(t, errors.Is(SetAutoIncrementCommand(500).ValidateAbove(500), ErrAutoIncrementTooLow))
+	})
+}
+
+func TestTableCommandsCanonical(t *testing.T) {
+	expected := "ENGINE=InnoDB, AUTO_INCREMENT=100, DEFAULT CHARSET=utf8mb4, COLLATE=utf8mb4_bin, " +
+		"ROW_FORMAT=DYNAMIC, COMPRESSION = 'zlib', COMMENT='test'"
+
+	t.Run("it renders table options in canonical order", func(t *testing.T) {
+		c := TableCommands{
+			SetCommentCommand("test"),
+			SetRowFormatCommand("dynamic"),
+			SetCollationCommand("utf8mb4_bin"),
+			SetCompressionCommand("zlib"),
+			SetAutoIncrementCommand(100),
+			SetCharsetCommand("utf8mb4"),
+			SetEngineCommand("InnoDB"),
+		}
+
+		assert.Equal(t, expected, c.ToSQL())
+	})
+
+	t.Run("it renders the same regardless of insertion order", func(t *testing.T) {
+		c := TableCommands{
+			SetEngineCommand("InnoDB"),
+			SetCompressionCommand("zlib"),
+			SetCharsetCommand("utf8mb4"),
+			SetCommentCommand("test"),
+			SetAutoIncrementCommand(100),
+			SetRowFormatCommand("dynamic"),
+			SetCollationCommand("utf8mb4_bin"),
+		}
+
+		assert.Equal(t, expected, c.ToSQL())
+	})
+
+	t.Run("it renders merge table options after other options", func(t *testing.T) {
+		c := TableCommands{
+			SetUnionCommand{"t1", "t2"},
+			SetInsertMethodCommand("last"),
+			SetEngineCommand("MRG_MyISAM"),
+		}
+
+		assert.Equal(t, "ENGINE=MRG_MyISAM, INSERT_METHOD=LAST, UNION=(`t1`, `t2`)", c.ToSQL())
+	})
+
+	t.Run("it keeps other commands in place", func(t *testing.T) {
+		c := TableCommands{
+			SetCommentCommand("test"),
+			DropColumnCommand("a"),
+			SetEngineCommand("InnoDB"),
+			testCommand("b"),
+		}
+
+		assert.Equal(t, TableCommands{
+			SetEngineCommand("InnoDB"),
+			DropColumnCommand("a"),
+			SetCommentCommand("test"),
+			testCommand("b"),
+		}, c.Canonical())
+		assert.Equal(t, SetCommentCommand("test"), c[0])
+	})
+}
@@ -1,11 +1,57 @@
 package migrator
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
+func TestTableUpAndDown(t *testing.T) {
+	assert := assert.New(t)
+
+	table := Table{Name: "posts"}
+	table.ID("id")
+
+	up := table.Up()
+	assert.Len(up.pool, 1)
+	assert.Equal(createTableCommand{table}, up.pool[0])
+	assert.Contains(up.pool[0].ToSQL(), "CREATE TABLE `posts` (")
+
+	down := table.Down()
+	assert.Len(down.pool, 1)
+	assert.Equal(dropTableCommand{"posts", true, ""}, down.pool[0])
+	assert.Equal("DROP TABLE IF EXISTS `posts`", down.pool[0].ToSQL())
+}
+
+func TestTableValidate(t *testing.T) {
+	t.Run("it passes valid columns", func(t *testing.T) {
+		table := Table{Name: "posts"}
+		table.ID("id")
+		table.Timestamps()
+		table.Column("token", String{Default: "(UUID())"})
+
+		assert.Nil(t, table.Validate())
+	})
+
+	t.Run("it returns error on function default not wrapped with parentheses", func(t *testing.T) {
+		table := Table{Name: "posts"}
+		table.ID("id")
+		table.Column("token", Integer{Default: "UUID()"})
+
+		err := table.Validate()
+		assert.True(t, errors.Is(err, ErrInvalidDefaultExpression))
+		assert.Contains(t, err.Error(), "column `token`")
+	})
+
+	t.Run("it returns error on empty expression default", func(t *testing.T) {
+		table := Table{Name: "posts"}
+		table.Column("token", String{Default: "()"})
+
+		assert.True(t, errors.Is(table.Validate(), ErrEmptyDefaultExpression))
+	})
+}
+
 func TestTableColumns(t *testing.T) {
 	c := testColumnType("test")
 
@@ -81,6 +127,37 @@ func TestBooleanColumn(t *testing.T) {
 	assert.Equal(Integer{Prefix: "tiny", Default: "1", Unsigned: true, Precision: 1}, table.columns[0].definition)
 }
 
+func TestBooleanWithDefaultColumn(t *testing.T) {
+	t.Run("it renders canonical default by default", func(t *testing.T) {
+		table := Table{}
+		table.BooleanWithDefault("active", true)
+		table.BooleanWithDefault("deleted", false)
+
+		active := true
+		assert.Len(t, table.columns, 2)
+		assert.Equal(t, Integer{Prefix: "tiny", Unsigned: true, Precision: 1, BooleanDefault: &active}, table.columns[0].definition)
+		assert.Equal(t, "`active` tinyint(1) unsigned NOT NULL DEFAULT 1, `deleted` tinyint(1) unsigned NOT NULL DEFAULT 0", table.columns.render(Options{}))
+	})
+
+	t.Run("it renders boolean literals default", func(t *testing.T) {
+		table := Table{}
+		table.BooleanWithDefault("active", true)
+		table.BooleanWithDefault("deleted", false)
+
+		assert.Equal(t, "`active` tinyint(1) unsigned NOT NULL DEFAULT TRUE, `deleted` tinyint(1) unsigned NOT NULL DEFAULT FALSE", table.columns.render(Options{BooleanLiterals: true}))
+	})
+
+	t.Run("it renders the same table with both styles", func(t *testing.T) {
+		table := Table{Name: "flags"}
+		table.BooleanWithDefault("active", true)
+
+		c := createTableCommand{table}
+
+		assert.Contains(t, c.ToSQLWithOptions(Options{}), "`active` tinyint(1) unsigned NOT NULL DEFAULT 1")
+		assert.Contains(t, c.ToSQLWithOptions(Options{BooleanLiterals: true}), "`active` tinyint(1) unsigned NOT NULL DEFAULT TRUE")
+	})
+}
+
 func TestUUIDColumn(t *testing.T) {
 	assert := assert.New(t)
 	table := Table{}
@@ -91,7 +168,7 @@ func TestUUIDColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("uuid", table.columns[0].field)
-	assert.Equal(String{Default: "1111", Fixed: true, Precision: 36, Nullable: true}, table.columns[0].definition)
+	assert.Equal(String{Default: "1111", Fixed: true, Precision: 36, Nullable: Null}, table.columns[0].definition)
 }
 
 func TestTimestampsColumn(t *testing.T) {
@@ -109,6 +186,17 @@ func TestTimestampsColumn(t *testing.T) {
 	assert.Equal(Timable{Type: "timestamp", Precision: 6, Default: "CURRENT_TIMESTAMP(6)", OnUpdate: "CURRENT_TIMESTAMP(6)"}, table.columns[1].definition)
 }
 
+func TestSoftDeletesColumn(t *testing.T) {
+	assert := assert.New(t)
+	table := Table{}
+
+	table.SoftDeletes()
+
+	assert.Len(table.columns, 1)
+	assert.Equal("deleted_at", table.columns[0].field)
+	assert.Equal(Timable{Type: "timestamp", Precision: 6, Nullable: Null}, table.columns[0].definition)
+}
+
 func TestIntColumn(t *testing.T) {
 	assert := assert.New(t)
 	table := Table{}
@@ -210,7 +298,19 @@ func TestTextColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("string", table.columns[0].field)
-	assert.Equal(Text{Nullable: true}, table.columns[0].definition)
+	assert.Equal(Text{Nullable: Null}, table.columns[0].definition)
+}
+
+func TestTextWithCharsetColumn(t *testing.T) {
+	assert := assert.New(t)
+	table := Table{}
+
+	table.TextWithCharset("body", "utf8mb4", "utf8mb4_unicode_ci", false)
+
+	assert.Len(table.columns, 1)
+	assert.Equal("body", table.columns[0].field)
+	assert.Equal(Text{Charset: "utf8mb4", Collate: "utf8mb4_unicode_ci"}, table.columns[0].definition)
+	assert.Equal("`body` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL", table.columns.render(Options{}))
 }
 
 func TestBlobColumn(t *testing.T) {
@@ -223,7 +323,7 @@ func TestBlobColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("string", table.columns[0].field)
-	assert.Equal(Text{Blob: true, Nullable: true}, table.columns[0].definition)
+	assert.Equal(Text{Blob: true, Nullable: Null}, table.columns[0].definition)
 }
 
 func TestJsonColumn(t *testing.T) {
@@ -249,7 +349,7 @@ func TestTimestampColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("date", table.columns[0].field)
-	assert.Equal(Timable{Nullable: true, Default: "CURRENT_TIMESTAMP"}, table.columns[0].definition)
+	assert.Equal(Timable{Nullable: Null, Default: "CURRENT_TIMESTAMP"}, table.columns[0].definition)
 }
 
 func TestPreciseTimestampColumn(t *testing.T) {
@@ -262,7 +362,7 @@ func TestPreciseTimestampColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("date", table.columns[0].field)
-	assert.Equal(Timable{Precision: 3, Nullable: true, Default: "CURRENT_TIMESTAMP"}, table.columns[0].definition)
+	assert.Equal(Timable{Precision: 3, Nullable: Null, Default: "CURRENT_TIMESTAMP"}, table.columns[0].definition)
 }
 
 func TestDateColumn(t *testing.T) {
@@ -275,7 +375,7 @@ func TestDateColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("date", table.columns[0].field)
-	assert.Equal(Timable{Type: "date", Nullable: true, Default: "NOW()"}, table.columns[0].definition)
+	assert.Equal(Timable{Type: "date", Nullable: Null, Default: "NOW()"}, table.columns[0].definition)
 }
 
 func TestTimeColumn(t *testing.T) {
@@ -288,7 +388,7 @@ func TestTimeColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("time", table.columns[0].field)
-	assert.Equal(Timable{Type: "time", Nullable: true, Default: "NOW()"}, table.columns[0].definition)
+	assert.Equal(Timable{Type: "time", Nullable: Null, Default: "NOW()"}, table.columns[0].definition)
 }
 
 func TestYearColumn(t *testing.T) {
@@ -301,7 +401,7 @@ func TestYearColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("year", table.columns[0].field)
-	assert.Equal(Timable{Type: "year", Nullable: true, Default: "YEAR(NOW())"}, table.columns[0].definition)
+	assert.Equal(Timable{Type: "year", Nullable: Null, Default: "YEAR(NOW())"}, table.columns[0].definition)
 }
 
 func TestBinaryColumn(t *testing.T) {
@@ -314,7 +414,7 @@ func TestBinaryColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("binary", table.columns[0].field)
-	assert.Equal(Binary{Fixed: true, Precision: 36, Nullable: true}, table.columns[0].definition)
+	assert.Equal(Binary{Fixed: true, Precision: 36, Nullable: Null}, table.columns[0].definition)
 }
 
 func TestVarbinaryColumn(t *testing.T) {
@@ -327,7 +427,38 @@ func TestVarbinaryColumn(t *testing.T) {
 
 	assert.Len(table.columns, 1)
 	assert.Equal("binary", table.columns[0].field)
-	assert.Equal(Binary{Precision: 36, Nullable: true}, table.columns[0].definition)
+	assert.Equal(Binary{Precision: 36, Nullable: Null}, table.columns[0].definition)
+}
+
+func TestGeneratedColumn(t *testing.T) {
+	t.Run("it adds generated column", func(t *testing.T) {
+		table := Table{}
+		table.Generated("total", "int", "price * quantity", false)
+
+		assert.Len(t, table.columns, 1)
+		assert.Equal(t, "total", table.columns[0].field)
+		assert.Equal(t, Generated{Type: "int", Expression: "price * quantity"}, table.columns[0].definition)
+	})
+
+	t.Run("it creates table with indexed stored generated column", func(t *testing.T) {
+		table := Table{Name: "users"}
+		table.Index("full_name_idx", "full_name")
+		table.ID("id")
+		table.Varchar("first_name", 64)
+		table.Varchar("last_name", 64)
+		table.Generated("full_name", "varchar(129)", "CONCAT(first_name, ' ', last_name)", true)
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `users` (`id` bigint unsigned NOT NULL AUTO_INCREMENT, "+
+				"`first_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL, "+
+				"`last_name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL, "+
+				"`full_name` varchar(129) AS (CONCAT(first_name, ' ', last_name)) STORED NOT NULL, "+
+				"KEY `full_name_idx` (`full_name`), PRIMARY KEY (`id`)) "+
+				"ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			createTableCommand{table}.ToSQL(),
+		)
+	})
 }
 
 func TestTablePrimaryIndex(t *testing.T) {
@@ -405,6 +536,41 @@ func TestTableIndex(t *testing.T) {
 	})
 }
 
+func TestTableInvisibleIndex(t *testing.T) {
+	t.Run("it skips adding key on empty columns list", func(t *testing.T) {
+		assert := assert.New(t)
+		table := Table{}
+
+		table.InvisibleIndex("test")
+
+		assert.Nil(table.indexes)
+	})
+
+	t.Run("it adds invisible key", func(t *testing.T) {
+		assert := assert.New(t)
+		table := Table{Name: "table"}
+
+		table.InvisibleIndex("test_idx", "name")
+
+		assert.Len(table.indexes, 1)
+		assert.Equal(Key{Name: "test_idx", Columns: []string{"name"}, Invisible: true}, table.indexes[0])
+	})
+
+	t.Run("it creates table with invisible secondary index", func(t *testing.T) {
+		table := Table{Name: "table"}
+		table.ID("id")
+		table.Varchar("name", 64)
+		table.InvisibleIndex("name_idx", "name")
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `table` (`id` bigint unsigned NOT NULL AUTO_INCREMENT, `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL, "+
+				"PRIMARY KEY (`id`), KEY `name_idx` (`name`) INVISIBLE) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			createTableCommand{table}.ToSQL(),
+		)
+	})
+}
+
 func TestTableForeignIndex(t *testing.T) {
 	assert := assert.New(t)
 	table := Table{Name: "table"}
@@ -414,11 +580,63 @@ func TestTableForeignIndex(t *testing.T) {
 
 	table.Foreign("test_id", "id", "tests", "set null", "cascade")
 
-	assert.Len(table.indexes, 1)
-	assert.Equal(Key{Name: "table_test_id_foreign", Columns: []string{"test_id"}}, table.indexes[0])
+	assert.Nil(table.indexes)
+	assert.Equal(keys{{Name: "table_test_id_foreign", Columns: []string{"test_id"}}}, table.indexes.withImplicit(table.implicit))
 	assert.Len(table.foreigns, 1)
 	assert.Equal(
 		Foreign{Key: "table_test_id_foreign", Column: "test_id", Reference: "id", On: "tests", OnUpdate: "set null", OnDelete: "cascade"},
 		table.foreigns[0],
 	)
 }
+
+func TestTableInvalidForeign(t *testing.T) {
+	table := Table{Name: "table"}
+	table.Column("test_id", testColumnType("int"))
+	table.Foreign("test_id", "", "tests", "", "")
+	table.Foreign("test_id", "id", "", "", "")
+
+	assert.Nil(t, table.implicit)
+	assert.Nil(t, table.foreigns)
+	assert.Equal(
+		t,
+		"CREATE TABLE `table` (`test_id` int) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+		createTableCommand{table}.ToSQL(),
+	)
+}
+
+func TestTableForeignIndexDetection(t *testing.T) {
+	t.Run("it skips foreign index when existing index covers the column", func(t *testing.T) {
+		table := Table{Name: "table"}
+		table.Index("tenant_user_idx", "test_id", "user_id")
+		table.Foreign("test_id", "id", "tests", "", "")
+
+		assert.Equal(t, keys{{Name: "tenant_user_idx", Columns: []string{"test_id", "user_id"}}}, table.indexes.withImplicit(table.implicit))
+		assert.Len(t, table.foreigns, 1)
+	})
+
+	t.Run("it skips foreign index when covering index is declared after foreign key", func(t *testing.T) {
+		table := Table{Name: "table"}
+		table.Column("test_id", testColumnType("int"))
+		table.Foreign("test_id", "id", "tests", "", "")
+		table.Index("tenant_user_idx", "test_id", "user_id")
+
+		assert.Equal(
+			t,
+			"CREATE TABLE `table` (`test_id` int, KEY `tenant_user_idx` (`test_id`, `user_id`), "+
+				"CONSTRAINT `table_test_id_foreign` FOREIGN KEY (`test_id`) REFERENCES `tests` (`id`)) "+
+				"ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci",
+			createTableCommand{table}.ToSQL(),
+		)
+	})
+
+	t.Run("it adds foreign index when existing index does not start with the column", func(t *testing.T) {
+		table := Table{Name: "table"}
+		table.Index("user_tenant_idx", "user_id", "test_id")
+		table.Foreign("test_id", "id", "tests", "", "")
+
+		indexes := table.indexes.withImplicit(table.implicit)
+		assert.Len(t, indexes, 2)
+		assert.Equal(t, Key{Name: "table_test_id_foreign", Columns: []string{"test_id"}}, indexes[1])
+		assert.Len(t, table.foreigns, 1)
+	})
+}
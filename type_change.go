@@ -0,0 +1,219 @@
+package migrator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TypeChange classifies the change of the column type.
+type TypeChange int
+
+const (
+	// TypeWidening keeps all existing values, the type is the same or accepts a wider range of values
+	TypeWidening TypeChange = iota
+	// TypeNarrowing could truncate or reject existing values
+	TypeNarrowing
+	// TypeIncompatible changes the type family, e.g. integer to string, or the type is unknown
+	TypeIncompatible
+)
+
+var integerSizes = list{"tiny", "small", "medium", "", "big"}
+var textSizes = list{"tiny", "", "medium", "long"}
+
+// ClassifyTypeChange compares numeric, string and binary column types.
+// Only the type and the character set are compared, other column attributes are ignored.
+// Character set change is treated as narrowing, as not every character could be converted.
+func ClassifyTypeChange(old ColumnType, new ColumnType) TypeChange {
+	switch o := old.(type) {
+	case Integer:
+		if n, ok := new.(Integer); ok {
+			return classifyIntegerChange(o, n)
+		}
+	case Floatable:
+		if n, ok := new.(Floatable); ok {
+			return classifyFloatableChange(o, n)
+		}
+	case String:
+		if n, ok := new.(String); ok {
+			return withCharset(classifySize(int(o.Precision), int(n.Precision)), o.Charset, o.Collate, n.Charset, n.Collate)
+		}
+	case Text:
+		if n, ok := new.(Text); ok && o.Blob == n.Blob {
+			change := classifySize(sizeRank(textSizes, o.Prefix), sizeRank(textSizes, n.Prefix))
+			if o.Blob {
+				return change
+			}
+
+			return withCharset(change, o.Charset, o.Collate, n.Charset, n.Collate)
+		}
+	case Binary:
+		if n, ok := new.(Binary); ok {
+			return classifyBinaryChange(o, n)
+		}
+	}
+
+	return TypeIncompatible
+}
+
+// withCharset narrows the change if the character set of the column is changed
+func withCharset(change TypeChange, oldCharset string, oldCollate string, newCharset string, newCollate string) TypeChange {
+	if change == TypeWidening && columnCharset(oldCharset, oldCollate) != columnCharset(newCharset, newCollate) {
+		return TypeNarrowing
+	}
+
+	return change
+}
+
+// columnCharset returns the character set of the column, taken from the collation if it is not set.
+// Column without both is rendered with utf8mb4 collation.
+func columnCharset(charset string, collate string) string {
+	if charset != "" {
+		return normalizeCharset(charset)
+	}
+
+	if collate != "" {
+		return strings.Split(normalizeCharset(collate), "_")[0]
+	}
+
+	return "utf8mb4"
+}
+
+// classifyBinaryChange compares the lengths, fixed length binary values are padded with zero bytes,
+// so changing the length of binary or converting varbinary to binary changes existing values.
+func classifyBinaryChange(o Binary, n Binary) TypeChange {
+	if n.Fixed && (!o.Fixed || o.Precision != n.Precision) {
+		return TypeNarrowing
+	}
+
+	return classifySize(int(o.Precision), int(n.Precision))
+}
+
+func classifyIntegerChange(o Integer, n Integer) TypeChange {
+	oldSize := sizeRank(integerSizes, o.Prefix)
+	newSize := sizeRank(integerSizes, n.Prefix)
+	if oldSize < 0 || newSize < 0 {
+		return TypeIncompatible
+	}
+
+	// negative values are lost on unsigned type
+	if !o.Unsigned && n.Unsigned {
+		return TypeNarrowing
+	}
+
+	// upper half of unsigned range requires a bigger signed type
+	if o.Unsigned && !n.Unsigned {
+		if newSize > oldSize {
+			return TypeWidening
+		}
+
+		return TypeNarrowing
+	}
+
+	return classifySize(oldSize, newSize)
+}
+
+func classifyFloatableChange(o Floatable, n Floatable) TypeChange {
+	oldType, oldPrecision, oldScale := floatableSpec(o)
+	newType, newPrecision, newScale := floatableSpec(n)
+
+	if !o.Unsigned && n.Unsigned {
+		return TypeNarrowing
+	}
+
+	switch {
+	case oldType == "decimal" && newType == "decimal":
+		return classifyDigits(oldPrecision, oldScale, newPrecision, newScale)
+	case oldType == "decimal" || newType == "decimal":
+		return TypeIncompatible
+	case oldType == "double" && newType == "float":
+		return TypeNarrowing
+	case newPrecision == 0:
+		// no M,D limits
+		return TypeWidening
+	case oldPrecision == 0:
+		return TypeNarrowing
+	}
+
+	return classifyDigits(oldPrecision, oldScale, newPrecision, newScale)
+}
+
+// floatableSpec normalizes the type family with total and fractional digits.
+// Decimal defaults to (10,0). Float and double have zero digits without M,D,
+// float(p) with precision only is float for p up to 24 and double otherwise.
+func floatableSpec(f Floatable) (string, int, int) {
+	family := floatableFamily(f.Type)
+	precision, scale := int(f.Precision), int(f.Scale)
+
+	switch {
+	case family == "decimal" && precision == 0:
+		precision = 10
+	case family != "decimal" && scale == 0:
+		if family == "float" && precision > 24 {
+			family = "double"
+		}
+
+		precision = 0
+	}
+
+	return family, precision, scale
+}
+
+// classifyDigits compares fractional and integer digits of M,D definitions
+func classifyDigits(oldPrecision int, oldScale int, newPrecision int, newScale int) TypeChange {
+	if newScale < oldScale || newPrecision-newScale < oldPrecision-oldScale {
+		return TypeNarrowing
+	}
+
+	return TypeWidening
+}
+
+func floatableFamily(t string) string {
+	switch strings.ToLower(t) {
+	case "decimal", "numeric":
+		return "decimal"
+	case "double", "real":
+		return "double"
+	}
+
+	return "float"
+}
+
+func sizeRank(sizes list, prefix string) int {
+	for i, size := range sizes {
+		if strings.EqualFold(size, prefix) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func classifySize(old int, new int) TypeChange {
+	if old < 0 || new < 0 {
+		return TypeIncompatible
+	}
+
+	if new < old {
+		return TypeNarrowing
+	}
+
+	return TypeWidening
+}
+
+// ModifyColumnType builds the command changing the type of the column.
+// The command is returned together with ErrTypeNarrowing, if existing values could be truncated.
+// Empty command and ErrIncompatibleTypeChange returns for incompatible types.
+//
+// Example:
+//		migrator.ModifyColumnType("views", migrator.Integer{}, migrator.Integer{Prefix: "big"})
+//			↪️ MODIFY `views` bigint NOT NULL
+func ModifyColumnType(name string, old ColumnType, column ColumnType) (ModifyColumnCommand, error) {
+	switch ClassifyTypeChange(old, column) {
+	case TypeNarrowing:
+		return ModifyColumnCommand{Name: name, Column: column, Old: old}, fmt.Errorf("column `%s`: %w", name, ErrTypeNarrowing)
+	case TypeIncompatible:
+		return ModifyColumnCommand{}, fmt.Errorf("column `%s`: %w", name, ErrIncompatibleTypeChange)
+	}
+
+	return ModifyColumnCommand{Name: name, Column: column, Old: old}, nil
+}
@@ -0,0 +1,116 @@
+package migrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyTypeChange(t *testing.T) {
+	t.Run("it classifies integer changes", func(t *testing.T) {
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Integer{}, Integer{Prefix: "big"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Integer{Prefix: "tiny"}, Integer{Prefix: "tiny"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Integer{Unsigned: true}, Integer{Prefix: "big"}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Integer{Prefix: "big"}, Integer{}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Integer{}, Integer{Prefix: "big", Unsigned: true}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Integer{Unsigned: true}, Integer{}))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(Integer{Prefix: "huge"}, Integer{}))
+	})
+
+	t.Run("it classifies floatable changes", func(t *testing.T) {
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{}, Floatable{Type: "double"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(
+			Floatable{Type: "decimal", Precision: 10, Scale: 2},
+			Floatable{Type: "numeric", Precision: 12, Scale: 4},
+		))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Floatable{Type: "double"}, Floatable{Type: "float"}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(
+			Floatable{Type: "decimal", Precision: 10, Scale: 2},
+			Floatable{Type: "decimal", Precision: 10, Scale: 4},
+		))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(Floatable{Type: "double"}, Floatable{Type: "decimal"}))
+	})
+
+	t.Run("it compares digits of floating point types", func(t *testing.T) {
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Floatable{Precision: 10, Scale: 2}, Floatable{Precision: 4, Scale: 2}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(
+			Floatable{Type: "double", Precision: 10, Scale: 4},
+			Floatable{Type: "double", Precision: 10, Scale: 2},
+		))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Floatable{Type: "double"}, Floatable{Type: "double", Precision: 10, Scale: 2}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{Precision: 4, Scale: 2}, Floatable{Precision: 10, Scale: 2}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{Precision: 10, Scale: 2}, Floatable{}))
+	})
+
+	t.Run("it treats float with precision only as float or double", func(t *testing.T) {
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Floatable{Precision: 30}, Floatable{Precision: 10}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{Type: "double"}, Floatable{Precision: 30}))
+	})
+
+	t.Run("it applies implicit decimal precision", func(t *testing.T) {
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Floatable{Type: "decimal"}, Floatable{Type: "decimal", Precision: 5}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{Type: "decimal"}, Floatable{Type: "decimal", Precision: 10}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Floatable{Type: "decimal", Precision: 5}, Floatable{Type: "numeric"}))
+	})
+
+	t.Run("it classifies string changes", func(t *testing.T) {
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(String{Fixed: true, Precision: 36}, String{Precision: 64}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(String{Precision: 255}, String{Precision: 64}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Text{}, Text{Prefix: "medium"}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Text{Prefix: "long"}, Text{}))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(Text{}, Text{Blob: true}))
+	})
+
+	t.Run("it classifies charset changes as narrowing", func(t *testing.T) {
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(
+			String{Precision: 10, Charset: "utf8mb4"},
+			String{Precision: 10, Charset: "latin1"},
+		))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(String{Precision: 10}, String{Precision: 20, Collate: "latin1_swedish_ci"}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Text{Charset: "utf8mb4"}, Text{Prefix: "long", Charset: "ascii"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(String{Precision: 10}, String{Precision: 10, Charset: "UTF8MB4"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(String{Precision: 10, Charset: "utf8"}, String{Precision: 10, Collate: "utf8mb3_bin"}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Text{Blob: true}, Text{Prefix: "long", Blob: true, Charset: "binary"}))
+	})
+
+	t.Run("it classifies binary changes", func(t *testing.T) {
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Binary{Precision: 16}, Binary{Precision: 32}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Binary{Fixed: true, Precision: 16}, Binary{Precision: 16}))
+		assert.Equal(t, TypeWidening, ClassifyTypeChange(Binary{Fixed: true, Precision: 16}, Binary{Fixed: true, Precision: 16}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Binary{Precision: 32}, Binary{Precision: 16}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Binary{Precision: 16}, Binary{Fixed: true, Precision: 32}))
+		assert.Equal(t, TypeNarrowing, ClassifyTypeChange(Binary{Fixed: true, Precision: 16}, Binary{Fixed: true, Precision: 32}))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(Binary{Precision: 16}, String{Precision: 16}))
+	})
+
+	t.Run("it classifies changes between type families as incompatible", func(t *testing.T) {
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(Integer{}, String{Precision: 255}))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(String{Precision: 255}, Text{}))
+		assert.Equal(t, TypeIncompatible, ClassifyTypeChange(testColumnType("int"), Integer{}))
+	})
+}
+
+func TestModifyColumnType(t *testing.T) {
+	t.Run("it modifies column on widening", func(t *testing.T) {
+		c, err := ModifyColumnType("views", Integer{}, Integer{Prefix: "big"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "MODIFY `views` bigint NOT NULL", c.ToSQL())
+	})
+
+	t.Run("it warns on narrowing", func(t *testing.T) {
+		c, err := ModifyColumnType("name", String{Precision: 255}, String{Precision: 64})
+
+		assert.True(t, errors.Is(err, ErrTypeNarrowing))
+		assert.Contains(t, err.Error(), "column `name`")
+		assert.Equal(t, "MODIFY `name` varchar(64) COLLATE utf8mb4_unicode_ci NOT NULL", c.ToSQL())
+	})
+
+	t.Run("it returns error on incompatible types", func(t *testing.T) {
+		c, err := ModifyColumnType("views", Integer{}, String{Precision: 64})
+
+		assert.True(t, errors.Is(err, ErrIncompatibleTypeChange))
+		assert.Equal(t, "", c.ToSQL())
+	})
+}
@@ -0,0 +1,176 @@
+package migrator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ErrNotNullWithoutDefault returns when NOT NULL column without default value is added to the table
+	ErrNotNullWithoutDefault = errors.New("NOT NULL column without default value fails on non-empty table")
+
+	// ErrInvalidColumnPosition returns when column is positioned after qualified or invalid column name
+	ErrInvalidColumnPosition = errors.New("AFTER should reference a simple column name")
+
+	// ErrMissingReferencedColumns returns when foreign key does not specify referenced columns
+	ErrMissingReferencedColumns = errors.New("Foreign key requires referenced columns")
+
+	// ErrForeignColumnsMismatch returns when foreign key columns do not correspond to referenced columns
+	ErrForeignColumnsMismatch = errors.New("Foreign key columns and referenced columns should have the same length")
+
+	// ErrEmptyDefaultExpression returns when expression default value is empty
+	ErrEmptyDefaultExpression = errors.New("Default expression should not be empty")
+
+	// ErrInvalidDefaultExpression returns when expression default value is not wrapped with parentheses
+	ErrInvalidDefaultExpression = errors.New("Default expression should be wrapped with parentheses")
+
+	// ErrOrphanedAutoincrement returns when auto_increment column is left without a key
+	ErrOrphanedAutoincrement = errors.New("auto_increment column must be the first column of a key")
+
+	// ErrEmptyCheckExpression returns when check constraint expression is empty
+	ErrEmptyCheckExpression = errors.New("Check constraint expression should not be empty")
+
+	// ErrRenamePrimaryKey returns when the primary key is renamed or index is renamed to PRIMARY
+	ErrRenamePrimaryKey = errors.New("PRIMARY key name could not be changed")
+
+	// ErrBinaryCharset returns when non-binary character set or collation is set for binary column type
+	ErrBinaryCharset = errors.New("Binary column types do not accept character set")
+
+	// ErrCollationMismatch returns when collation does not belong to the character set of the column
+	ErrCollationMismatch = errors.New("Collation does not belong to the character set")
+
+	// ErrAutoIncrementTooLow returns when auto_increment counter is set not above the current maximum value
+	ErrAutoIncrementTooLow = errors.New("AUTO_INCREMENT should be higher than the current maximum value")
+
+	// ErrEnumReorder returns when existing enum values are reordered or removed, which remaps stored data
+	ErrEnumReorder = errors.New("Existing enum values should keep their positions")
+
+	// ErrTypeNarrowing returns when the column type is narrowed, existing values could be truncated
+	ErrTypeNarrowing = errors.New("Column type narrowing could truncate existing values")
+
+	// ErrIncompatibleTypeChange returns when the column type is changed to the type of another family
+	ErrIncompatibleTypeChange = errors.New("Column type could not be safely converted")
+
+	// ErrIncompatibleRename returns when RENAME TO is combined with operations, which could not be executed together
+	ErrIncompatibleRename = errors.New("RENAME TO could not be combined with the command")
+
+	// ErrStandalonePartitioning returns when partition maintenance is combined with other commands in one statement
+	ErrStandalonePartitioning = errors.New("Partition maintenance could not be combined with other commands")
+
+	// ErrIncompatibleAlgorithm returns when command could not be executed with the requested ALGORITHM
+	ErrIncompatibleAlgorithm = errors.New("Command is not supported by the requested algorithm")
+)
+
+// Validator is implemented by commands able to detect problems before being executed.
+type Validator interface {
+	Validate() error
+}
+
+// Validate checks the command if it implements Validator.
+func Validate(c Command) error {
+	if v, ok := c.(Validator); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
+// isSimpleIdentifier checks if the value is a valid unqualified and unquoted identifier
+func isSimpleIdentifier(v string) bool {
+	if v == "" {
+		return false
+	}
+
+	for _, r := range v {
+		if r > unicode.MaxASCII {
+			continue
+		}
+		if r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateDefaultExpression checks the default value looking like an expression: `(...)`
+func validateDefaultExpression(v string) error {
+	if v == "" {
+		return nil
+	}
+
+	if !isDefaultExpression(v) {
+		if isFunctionCall(v) {
+			return fmt.Errorf("%s: %w", v, ErrInvalidDefaultExpression)
+		}
+
+		return nil
+	}
+
+	if strings.TrimSpace(v[1:len(v)-1]) == "" {
+		return ErrEmptyDefaultExpression
+	}
+
+	if !isWrappedInParentheses(v) {
+		return fmt.Errorf("%s: %w", v, ErrInvalidDefaultExpression)
+	}
+
+	return nil
+}
+
+// literalDefaultFunctions could be used as default value without wrapping with parentheses
+var literalDefaultFunctions = map[string]bool{
+	"CURRENT_TIMESTAMP": true,
+	"NOW":               true,
+	"LOCALTIME":         true,
+	"LOCALTIMESTAMP":    true,
+}
+
+// isFunctionCall checks if value looks like a function call, e.g. `UUID()` or `CONCAT('x', UUID())`,
+// which MySQL accepts as default value only when it is wrapped with parentheses.
+func isFunctionCall(v string) bool {
+	open := strings.IndexByte(v, '(')
+	if open <= 0 || v[len(v)-1] != ')' {
+		return false
+	}
+
+	name := v[:open]
+	if !isSimpleIdentifier(name) {
+		return false
+	}
+
+	return !literalDefaultFunctions[strings.ToUpper(name)]
+}
+
+// isWrappedInParentheses checks that the whole value is wrapped with one pair of parentheses,
+// parentheses inside quoted strings are ignored.
+func isWrappedInParentheses(v string) bool {
+	if len(v) < 2 || v[0] != '(' || v[len(v)-1] != ')' {
+		return false
+	}
+
+	depth := 0
+	var quote rune
+
+	for i, r := range v {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 && i != len(v)-1 {
+				return false
+			}
+		}
+	}
+
+	return depth == 0 && quote == 0
+}
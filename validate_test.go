@@ -0,0 +1,73 @@
+package migrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("it returns nil for command without validation", func(t *testing.T) {
+		assert.Nil(t, Validate(testCommand("test")))
+	})
+
+	t.Run("it returns error from validator", func(t *testing.T) {
+		c := AddColumnCommand{Name: "test", Column: Integer{}}
+
+		assert.Error(t, Validate(c))
+	})
+}
+
+func TestIsSimpleIdentifier(t *testing.T) {
+	assert.True(t, isSimpleIdentifier("created_at"))
+	assert.True(t, isSimpleIdentifier("$price1"))
+	assert.True(t, isSimpleIdentifier("назва"))
+	assert.False(t, isSimpleIdentifier(""))
+	assert.False(t, isSimpleIdentifier("tests.id"))
+	assert.False(t, isSimpleIdentifier("`id`"))
+	assert.False(t, isSimpleIdentifier("id name"))
+}
+
+func TestValidateDefaultExpression(t *testing.T) {
+	t.Run("it skips plain values", func(t *testing.T) {
+		assert.Nil(t, validateDefaultExpression(""))
+		assert.Nil(t, validateDefaultExpression("active"))
+		assert.Nil(t, validateDefaultExpression("(draft"))
+	})
+
+	t.Run("it passes valid expression", func(t *testing.T) {
+		assert.Nil(t, validateDefaultExpression("(CONCAT('x', UUID()))"))
+		assert.Nil(t, validateDefaultExpression("(CONCAT('(', ')'))"))
+	})
+
+	t.Run("it returns error on empty expression", func(t *testing.T) {
+		assert.True(t, errors.Is(validateDefaultExpression("()"), ErrEmptyDefaultExpression))
+		assert.True(t, errors.Is(validateDefaultExpression("(  )"), ErrEmptyDefaultExpression))
+	})
+
+	t.Run("it returns error on partially wrapped expression", func(t *testing.T) {
+		assert.True(t, errors.Is(validateDefaultExpression("(a) + (b)"), ErrInvalidDefaultExpression))
+	})
+
+	t.Run("it returns error on function call not wrapped with parentheses", func(t *testing.T) {
+		assert.True(t, errors.Is(validateDefaultExpression("UUID()"), ErrInvalidDefaultExpression))
+		assert.True(t, errors.Is(validateDefaultExpression("CONCAT('x', UUID())"), ErrInvalidDefaultExpression))
+	})
+
+	t.Run("it passes literal time functions", func(t *testing.T) {
+		assert.Nil(t, validateDefaultExpression("CURRENT_TIMESTAMP"))
+		assert.Nil(t, validateDefaultExpression("CURRENT_TIMESTAMP(6)"))
+		assert.Nil(t, validateDefaultExpression("now()"))
+		assert.Nil(t, validateDefaultExpression("draft (old)"))
+	})
+}
+
+func TestIsWrappedInParentheses(t *testing.T) {
+	assert.True(t, isWrappedInParentheses("(a)"))
+	assert.True(t, isWrappedInParentheses("((a) AND (b))"))
+	assert.True(t, isWrappedInParentheses("(name <> ')(')"))
+	assert.False(t, isWrappedInParentheses("a"))
+	assert.False(t, isWrappedInParentheses("(a) AND (b)"))
+	assert.False(t, isWrappedInParentheses("((a)"))
+}